@@ -0,0 +1,17 @@
+# Review the grants of several roles in one go
+data "civicrm_acl_audit" "staff" {
+  acl_role_ids = [
+    civicrm_acl_role.team_leader.id,
+    civicrm_acl_role.volunteer_manager.id,
+  ]
+}
+
+output "staff_grants" {
+  value = {
+    for role_id, rules in data.civicrm_acl_audit.staff.rules :
+    role_id => [
+      for rule in rules :
+      "${rule.deny ? "deny" : "allow"} ${rule.operation} on ${rule.object_missing ? "deleted ${rule.object_table} ${rule.object_id}" : coalesce(rule.object_name, "all of ${rule.object_table}")}"
+    ]
+  }
+}
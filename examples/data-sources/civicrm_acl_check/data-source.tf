@@ -0,0 +1,15 @@
+# Check that a team leader can edit the volunteers group
+data "civicrm_acl_check" "team_leader_edit" {
+  contact_id   = 202
+  operation    = "Edit"
+  object_table = "civicrm_group"
+  object_id    = civicrm_group.volunteers.id
+}
+
+output "team_leader_can_edit" {
+  value = data.civicrm_acl_check.team_leader_edit.allowed
+}
+
+output "deciding_rule" {
+  value = data.civicrm_acl_check.team_leader_edit.rule_id
+}
@@ -0,0 +1,13 @@
+# Find ACL roles nobody is assigned to and assignments of deleted roles
+data "civicrm_acl_health" "this" {}
+
+output "unassigned_acl_roles" {
+  value = [for role in data.civicrm_acl_health.this.orphaned_roles : role.name]
+}
+
+output "dangling_acl_assignments" {
+  value = [
+    for assignment in data.civicrm_acl_health.this.dangling_assignments :
+    "${assignment.entity_table}/${assignment.entity_id} -> role ${assignment.acl_role_id}"
+  ]
+}
@@ -0,0 +1,11 @@
+# Review everything the team leader role grants
+data "civicrm_acl_role_rules" "team_leader" {
+  acl_role_id = civicrm_acl_role.team_leader.id
+}
+
+output "team_leader_grants" {
+  value = [
+    for rule in data.civicrm_acl_role_rules.team_leader.rules :
+    "${rule.deny ? "deny" : "allow"} ${rule.operation} on ${rule.object_missing ? "deleted ${rule.object_table} ${rule.object_id}" : coalesce(rule.object_name, "all of ${rule.object_table}")}"
+  ]
+}
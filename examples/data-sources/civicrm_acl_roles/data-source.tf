@@ -0,0 +1,13 @@
+data "civicrm_acl_roles" "active" {
+  is_active = true
+}
+
+# The ACL rules of every active role in a single request
+data "civicrm_acl_audit" "all" {
+  acl_role_ids = [for role in data.civicrm_acl_roles.active.roles : role.id]
+}
+
+# ACL role IDs keyed by name
+output "acl_role_ids" {
+  value = { for role in data.civicrm_acl_roles.active.roles : role.name => role.id }
+}
@@ -0,0 +1,13 @@
+# Look up the organization that owns the site by its external identifier
+data "civicrm_contact" "head_office" {
+  external_identifier = "ORG-0001"
+}
+
+# Look up a contact by email address
+data "civicrm_contact" "bounce_handler" {
+  email = "bounces@example.org"
+}
+
+output "head_office_id" {
+  value = data.civicrm_contact.head_office.id
+}
@@ -0,0 +1,17 @@
+# Look up a field by the name of its custom group
+data "civicrm_custom_field" "shirt_size" {
+  custom_group_name = "Volunteer_Details"
+  name              = "Shirt_Size"
+}
+
+# Add an option to the field's option group
+resource "civicrm_option_value" "shirt_size_xxl" {
+  option_group_id = data.civicrm_custom_field.shirt_size.option_group_id
+  name            = "XXL"
+  label           = "XXL"
+  value           = "XXL"
+}
+
+output "shirt_size_column" {
+  value = data.civicrm_custom_field.shirt_size.column_name
+}
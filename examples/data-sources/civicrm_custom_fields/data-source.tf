@@ -0,0 +1,19 @@
+data "civicrm_custom_fields" "volunteer" {
+  custom_group_name = "Volunteer_Details"
+}
+
+locals {
+  # API v4 names of the active fields, e.g. "Volunteer_Details.Shirt_Size"
+  volunteer_columns = [for f in data.civicrm_custom_fields.volunteer.fields : f.api_name if f.is_active]
+}
+
+# One table column per active custom field
+resource "civicrm_search_display" "volunteer_details" {
+  saved_search_id = 12
+  name            = "Volunteer_Details_Table"
+  label           = "Volunteer Details"
+  type            = "table"
+  settings = jsonencode({
+    columns = [for name in local.volunteer_columns : { type = "field", key = name }]
+  })
+}
@@ -0,0 +1,25 @@
+data "civicrm_entity_fields" "contact" {
+  entity = "Contact"
+  action = "create"
+}
+
+locals {
+  # Options of the prefix field, keyed by name
+  prefixes = {
+    for option in one([for f in data.civicrm_entity_fields.contact.fields : f.options if f.name == "prefix_id"]) :
+    option.name => option.id
+  }
+}
+
+variable "contact_fields" {
+  type    = list(string)
+  default = ["first_name", "last_name", "email_primary.email"]
+}
+
+# Fail the plan when a configured field does not exist
+check "contact_fields_exist" {
+  assert {
+    condition     = alltrue([for f in var.contact_fields : contains(data.civicrm_entity_fields.contact.field_names, f)])
+    error_message = "contact_fields contains fields that Contact does not have."
+  }
+}
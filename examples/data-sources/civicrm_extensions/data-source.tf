@@ -0,0 +1,22 @@
+# Extensions that are installed and enabled
+data "civicrm_extensions" "enabled" {
+  status = "installed"
+}
+
+# Only create the search display when SearchKit is enabled
+resource "civicrm_search_display" "volunteers" {
+  count = contains(data.civicrm_extensions.enabled.keys, "org.civicrm.search_kit") ? 1 : 0
+
+  saved_search_id = 12
+  name            = "Volunteers_Table"
+  label           = "Volunteers"
+  type            = "table"
+}
+
+# Fail the plan when Mosaico is missing
+check "mosaico_enabled" {
+  assert {
+    condition     = contains(data.civicrm_extensions.enabled.keys, "uk.co.vedaconsulting.mosaico")
+    error_message = "The Mosaico extension must be installed and enabled."
+  }
+}
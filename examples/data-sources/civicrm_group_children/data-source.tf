@@ -0,0 +1,8 @@
+# List the groups nested below the regional volunteers group
+data "civicrm_group_children" "regions" {
+  group_id = civicrm_group.volunteers.id
+}
+
+output "region_titles" {
+  value = [for child in data.civicrm_group_children.regions.children : child.title]
+}
@@ -0,0 +1,12 @@
+data "civicrm_group_contact_count" "newsletter" {
+  group_id      = civicrm_group.newsletter.id
+  refresh_cache = true
+}
+
+# Stop the plan before changing a group that still has members
+check "newsletter_empty" {
+  assert {
+    condition     = data.civicrm_group_contact_count.newsletter.contact_count == 0
+    error_message = "The newsletter group still has ${data.civicrm_group_contact_count.newsletter.contact_count} contacts."
+  }
+}
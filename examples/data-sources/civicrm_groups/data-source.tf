@@ -0,0 +1,21 @@
+# All active mailing lists
+data "civicrm_groups" "mailing_lists" {
+  group_type = "Mailing List"
+  is_active  = true
+}
+
+# Let mailing managers edit the members of every mailing list
+resource "civicrm_acl" "mailing_managers_edit" {
+  for_each = { for group in data.civicrm_groups.mailing_lists.groups : group.name => group }
+
+  name         = "mailing_managers_edit_${each.key}"
+  entity_id    = civicrm_acl_role.mailing_manager.id
+  operation    = "Edit"
+  object_table = "civicrm_group"
+  object_id    = each.value.id
+}
+
+# Groups of the regional chapters, named "Chapter: <region>"
+data "civicrm_groups" "chapters" {
+  title_prefix = "Chapter: "
+}
@@ -0,0 +1,18 @@
+# Look up a job by the API call it runs
+data "civicrm_job" "send_mailings" {
+  api_entity = "Job"
+  api_action = "process_mailing"
+}
+
+# Look up a job by name
+data "civicrm_job" "geocode" {
+  name = "Geocode and Parse Addresses"
+}
+
+# Fail the plan when mailings are not sent automatically
+check "mailing_job_active" {
+  assert {
+    condition     = data.civicrm_job.send_mailings.is_active
+    error_message = "The scheduled job that sends mailings is disabled."
+  }
+}
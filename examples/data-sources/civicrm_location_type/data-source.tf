@@ -0,0 +1,16 @@
+# Look up a location type by name
+data "civicrm_location_type" "work" {
+  name = "Work"
+}
+
+# Look up the default location type of the site
+data "civicrm_location_type" "default" {
+  is_default = true
+}
+
+resource "civicrm_email" "bounce" {
+  contact_id       = 1
+  email            = "bounces@example.org"
+  location_type_id = data.civicrm_location_type.work.id
+  is_primary       = true
+}
@@ -0,0 +1,17 @@
+# Look up a user-defined message template by title
+data "civicrm_message_template" "welcome" {
+  msg_title = "Volunteer Welcome"
+}
+
+# Look up the editable copy of a system workflow template
+data "civicrm_message_template" "online_receipt" {
+  workflow_name = "contribution_online_receipt"
+}
+
+output "welcome_template_id" {
+  value = data.civicrm_message_template.welcome.id
+}
+
+output "receipt_subject" {
+  value = data.civicrm_message_template.online_receipt.msg_subject
+}
@@ -0,0 +1,20 @@
+# All activity types of the site
+data "civicrm_option_values" "activity_types" {
+  option_group_name = "activity_type"
+}
+
+# Fail the plan when an activity type the configuration relies on is missing
+check "required_activity_types" {
+  assert {
+    condition = contains(
+      [for value in data.civicrm_option_values.activity_types.values : value.name],
+      "Volunteer_Shift",
+    )
+    error_message = "The Volunteer_Shift activity type does not exist."
+  }
+}
+
+# Map of activity type names to values
+output "activity_type_values" {
+  value = { for value in data.civicrm_option_values.activity_types.values : value.name => value.value }
+}
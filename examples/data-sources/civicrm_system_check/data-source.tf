@@ -0,0 +1,22 @@
+# Run the system checks after the configuration has been applied
+data "civicrm_system_check" "status" {
+  depends_on = [civicrm_custom_group.volunteer_info]
+}
+
+# Fail the run if CiviCRM reports a critical issue
+check "civicrm_status" {
+  assert {
+    condition = length([
+      for c in data.civicrm_system_check.status.checks : c
+      if contains(["critical", "alert", "emergency"], c.severity)
+    ]) == 0
+    error_message = "CiviCRM reports critical status messages."
+  }
+}
+
+output "warnings" {
+  value = [
+    for c in data.civicrm_system_check.status.checks : c.message
+    if c.severity == "warning"
+  ]
+}
@@ -0,0 +1,25 @@
+data "civicrm_system_info" "current" {}
+
+locals {
+  # SiteEmailAddress exists since CiviCRM 5.78
+  has_site_email_address = (
+    data.civicrm_system_info.current.major_version > 5 ||
+    (data.civicrm_system_info.current.major_version == 5 && data.civicrm_system_info.current.minor_version >= 78)
+  )
+}
+
+resource "civicrm_site_email_address" "default" {
+  count = local.has_site_email_address ? 1 : 0
+
+  display_name = "Organization Name"
+  email        = "info@example.org"
+  is_default   = true
+}
+
+# Fail the plan when CiviEvent is disabled
+check "civievent_enabled" {
+  assert {
+    condition     = contains(data.civicrm_system_info.current.enabled_components, "CiviEvent")
+    error_message = "The CiviEvent component must be enabled."
+  }
+}
@@ -0,0 +1,14 @@
+# All tags that can be used on contacts
+data "civicrm_tags" "contact" {
+  used_for = "civicrm_contact"
+}
+
+# The tags of the skills tagset, at any depth
+data "civicrm_tags" "skills" {
+  parent_id = civicrm_tag.skills.id
+}
+
+# Tag IDs keyed by their path, e.g. "skills/languages/spanish"
+output "skill_tag_ids" {
+  value = { for tag in data.civicrm_tags.skills.tags : tag.path => tag.id }
+}
@@ -0,0 +1,23 @@
+# Create a group for event coordinators
+resource "civicrm_group" "event_coordinators" {
+  name       = "event_coordinators"
+  title      = "Event Coordinators"
+  group_type = ["Access Control"]
+}
+
+# Create an ACL role and assign it to the group in one step
+resource "civicrm_acl_assignment" "event_coordinator" {
+  role_name        = "event_coordinator"
+  role_label       = "Event Coordinator"
+  role_description = "Can manage event participants"
+  group_id         = civicrm_group.event_coordinators.id
+}
+
+# Grant the role access to the group's contacts
+resource "civicrm_acl" "coordinators_edit" {
+  name         = "Coordinators edit participants"
+  entity_id    = civicrm_acl_assignment.event_coordinator.role_value
+  operation    = "Edit"
+  object_table = "civicrm_group"
+  object_id    = civicrm_group.event_coordinators.id
+}
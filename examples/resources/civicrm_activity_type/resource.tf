@@ -0,0 +1,16 @@
+# Activity type recorded for volunteer shifts
+resource "civicrm_activity_type" "volunteer_shift" {
+  name        = "Volunteer_Shift"
+  label       = "Volunteer Shift"
+  description = "A shift worked by a volunteer"
+  icon        = "fa-hands-helping"
+}
+
+# Activity type only available on cases
+resource "civicrm_activity_type" "home_visit" {
+  name      = "Home_Visit"
+  label     = "Home Visit"
+  icon      = "fa-home"
+  component = "CiviCase"
+  weight    = 10
+}
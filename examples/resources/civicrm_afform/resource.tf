@@ -0,0 +1,35 @@
+# Public sign-up form for volunteers
+resource "civicrm_afform" "volunteer_signup" {
+  name         = "afformVolunteerSignup"
+  title        = "Volunteer Sign-up"
+  server_route = "civicrm/volunteer-signup"
+  permission   = ["*always allow*"]
+
+  layout = <<-EOT
+    <af-form ctrl="afform">
+      <af-entity type="Individual" name="Individual1" label="Individual 1" actions="{create: true, update: false}" security="FBAC" />
+      <fieldset af-fieldset="Individual1" class="af-container" af-title="Your details">
+        <afblock-name-individual></afblock-name-individual>
+        <div af-join="Email" min="1" max="1">
+          <afblock-contact-email></afblock-contact-email>
+        </div>
+      </fieldset>
+      <button class="af-button btn btn-primary" crm-icon="fa-check" ng-click="afform.submit()">Submit</button>
+    </af-form>
+  EOT
+}
+
+# Dashlet shown on the CiviCRM dashboard of staff
+resource "civicrm_afform" "open_cases" {
+  name       = "afsearchOpenCases"
+  title      = "Open Cases"
+  type       = "search"
+  permission = ["access CiviCRM"]
+  placement  = ["dashboard_dashlet"]
+
+  layout = <<-EOT
+    <div af-fieldset="">
+      <crm-search-display-table search-name="Open_Cases" display-name="Open_Cases_Table"></crm-search-display-table>
+    </div>
+  EOT
+}
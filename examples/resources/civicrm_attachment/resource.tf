@@ -0,0 +1,11 @@
+# Attach a signed agreement to an activity
+resource "civicrm_attachment" "volunteer_agreement" {
+  entity_table = "civicrm_activity"
+  entity_id    = 1234
+  source       = "${path.module}/files/volunteer-agreement.pdf"
+  source_hash  = filesha256("${path.module}/files/volunteer-agreement.pdf")
+}
+
+output "agreement_url" {
+  value = civicrm_attachment.volunteer_agreement.url
+}
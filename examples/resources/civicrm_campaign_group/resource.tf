@@ -0,0 +1,12 @@
+# Target the volunteers group with a campaign, but leave out unsubscribed contacts
+resource "civicrm_campaign_group" "volunteers" {
+  campaign_id = 12
+  group_type  = "Include"
+  entity_id   = civicrm_group.volunteers.id
+}
+
+resource "civicrm_campaign_group" "unsubscribed" {
+  campaign_id = 12
+  group_type  = "Exclude"
+  entity_id   = civicrm_group.unsubscribed.id
+}
@@ -0,0 +1,34 @@
+# A custom group with its fields, managed as one unit
+resource "civicrm_custom_schema" "volunteer" {
+  name    = "volunteer_profile"
+  title   = "Volunteer Profile"
+  extends = "Individual"
+  style   = "Tab"
+
+  fields = [
+    {
+      name      = "skills"
+      label     = "Skills"
+      data_type = "String"
+      html_type = "Text"
+    },
+    {
+      name          = "available_since"
+      label         = "Available Since"
+      data_type     = "Date"
+      html_type     = "Select Date"
+      is_searchable = true
+    },
+    {
+      name          = "has_drivers_license"
+      label         = "Has Driver's License"
+      data_type     = "Boolean"
+      html_type     = "Radio"
+      default_value = "0"
+    },
+  ]
+}
+
+output "skills_field_id" {
+  value = civicrm_custom_schema.volunteer.field_ids["skills"]
+}
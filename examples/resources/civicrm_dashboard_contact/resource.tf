@@ -0,0 +1,8 @@
+# Place the "Activities" dashlet in the right-hand column of a contact's dashboard
+resource "civicrm_dashboard_contact" "activities" {
+  dashboard_id = 1
+  contact_id   = 202
+  column_no    = 1
+  weight       = 0
+  is_active    = true
+}
@@ -0,0 +1,28 @@
+# First name, last name and email must all match
+resource "civicrm_dedupe_rule" "first_name" {
+  dedupe_rule_group_id = civicrm_dedupe_rule_group.individual_strict.id
+  rule_field           = "first_name"
+  rule_weight          = 5
+}
+
+resource "civicrm_dedupe_rule" "last_name" {
+  dedupe_rule_group_id = civicrm_dedupe_rule_group.individual_strict.id
+  rule_field           = "last_name"
+  rule_weight          = 5
+}
+
+resource "civicrm_dedupe_rule" "email" {
+  dedupe_rule_group_id = civicrm_dedupe_rule_group.individual_strict.id
+  rule_table           = "civicrm_email"
+  rule_field           = "email"
+  rule_weight          = 10
+}
+
+# Compare only the first 5 characters of the postal code
+resource "civicrm_dedupe_rule" "postal_code" {
+  dedupe_rule_group_id = civicrm_dedupe_rule_group.individual_strict.id
+  rule_table           = "civicrm_address"
+  rule_field           = "postal_code"
+  rule_length          = 5
+  rule_weight          = 5
+}
@@ -0,0 +1,7 @@
+# Strict rule group used when matching contacts on public forms
+resource "civicrm_dedupe_rule_group" "individual_strict" {
+  title        = "Name and email"
+  contact_type = "Individual"
+  used         = "Unsupervised"
+  threshold    = 20
+}
@@ -0,0 +1,15 @@
+# Address of the contact that receives bounce notifications
+resource "civicrm_email" "bounce" {
+  contact_id       = 1
+  email            = "bounces@example.org"
+  location_type_id = 2
+  is_primary       = true
+}
+
+# Billing address kept on hold after repeated bounces
+resource "civicrm_email" "billing" {
+  contact_id = 1
+  email      = "billing@example.org"
+  is_billing = true
+  on_hold    = 1
+}
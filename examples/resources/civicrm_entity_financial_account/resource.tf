@@ -0,0 +1,12 @@
+# Book donations of financial type 1 to the donation income account
+resource "civicrm_entity_financial_account" "donation_income" {
+  entity_id            = 1
+  account_relationship = "Income Account is"
+  financial_account_id = 1
+}
+
+resource "civicrm_entity_financial_account" "donation_receivable" {
+  entity_id            = 1
+  account_relationship = "Accounts Receivable Account is"
+  financial_account_id = 7
+}
@@ -0,0 +1,7 @@
+# Event type with a fixed value, so that it matches across environments
+resource "civicrm_event_type" "summer_camp" {
+  name        = "Summer_Camp"
+  label       = "Summer Camp"
+  value       = "20"
+  description = "Multi-day camps for children and teenagers"
+}
@@ -0,0 +1,13 @@
+# Enable an extension shipped with CiviCRM
+resource "civicrm_extension" "search_kit" {
+  key = "org.civicrm.search_kit"
+}
+
+# Download a pinned release of an in-house extension and keep it disabled
+resource "civicrm_extension" "donor_portal" {
+  key      = "org.example.donorportal"
+  version  = "1.4.0"
+  download = true
+  url      = "https://git.example.org/civicrm/donorportal/releases/1.4.0/donorportal-1.4.0.zip"
+  enabled  = false
+}
@@ -0,0 +1,13 @@
+# Create a custom group type
+resource "civicrm_group_type" "volunteer_pool" {
+  name        = "Volunteer Pool"
+  label       = "Volunteer Pool"
+  description = "Groups that volunteers are recruited from"
+}
+
+# Use the group type on a group by its name
+resource "civicrm_group" "weekend_volunteers" {
+  name       = "weekend_volunteers"
+  title      = "Weekend Volunteers"
+  group_type = [civicrm_group_type.volunteer_pool.name]
+}
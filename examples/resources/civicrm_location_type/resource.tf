@@ -0,0 +1,7 @@
+# Location type for the addresses and phone numbers of branch offices
+resource "civicrm_location_type" "branch_office" {
+  name         = "Branch_Office"
+  display_name = "Branch Office"
+  vcard_name   = "WORK"
+  description  = "Addresses and phone numbers of regional branch offices"
+}
@@ -0,0 +1,23 @@
+# Activity type referenced by option group name
+resource "civicrm_option_value" "volunteer_shift" {
+  option_group_name = "activity_type"
+  name              = "Volunteer_Shift"
+  label             = "Volunteer Shift"
+  description       = "A shift worked by a volunteer"
+  icon              = "fa-clock-o"
+}
+
+# Individual prefix with a fixed value
+resource "civicrm_option_value" "prefix_prof" {
+  option_group_name = "individual_prefix"
+  label             = "Prof."
+  value             = "10"
+  weight            = 5
+}
+
+# Activity status with a color
+resource "civicrm_option_value" "waiting" {
+  option_group_name = "activity_status"
+  label             = "Waiting for Reply"
+  color             = "#f0ad4e"
+}
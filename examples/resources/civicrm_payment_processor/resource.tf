@@ -0,0 +1,31 @@
+variable "paypal_live_password" {
+  type      = string
+  sensitive = true
+}
+
+variable "paypal_test_password" {
+  type      = string
+  sensitive = true
+}
+
+# Live configuration
+resource "civicrm_payment_processor" "paypal" {
+  name                      = "PayPal"
+  payment_processor_type_id = 1
+  user_name                 = "payments_api1.example.org"
+  password                  = var.paypal_live_password
+  url_site                  = "https://www.paypal.com/"
+  url_api                   = "https://api-3t.paypal.com/"
+  is_default                = true
+}
+
+# Test configuration of the same processor
+resource "civicrm_payment_processor" "paypal_test" {
+  name                      = "PayPal"
+  payment_processor_type_id = 1
+  is_test                   = true
+  user_name                 = "payments-facilitator_api1.example.org"
+  password                  = var.paypal_test_password
+  url_site                  = "https://www.sandbox.paypal.com/"
+  url_api                   = "https://api-3t.sandbox.paypal.com/"
+}
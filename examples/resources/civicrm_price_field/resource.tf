@@ -0,0 +1,18 @@
+# Ticket selection of the price set of the annual conference
+resource "civicrm_price_field" "ticket" {
+  price_set_id = 5
+  label        = "Ticket"
+  html_type    = "Radio"
+  weight       = 1
+}
+
+# Optional extras that attendees can add to their ticket
+resource "civicrm_price_field" "extras" {
+  price_set_id       = 5
+  name               = "conference_extras"
+  label              = "Extras"
+  html_type          = "CheckBox"
+  is_required        = false
+  is_display_amounts = true
+  weight             = 2
+}
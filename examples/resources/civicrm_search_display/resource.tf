@@ -0,0 +1,25 @@
+# Table of the contacts matched by saved search 12
+resource "civicrm_search_display" "volunteers" {
+  saved_search_id = 12
+  name            = "Volunteers_Table"
+  label           = "Volunteers"
+  type            = "table"
+
+  settings = jsonencode({
+    limit = 50
+    pager = {}
+    sort  = [["sort_name", "ASC"]]
+    columns = [
+      {
+        type  = "field"
+        key   = "sort_name"
+        label = "Name"
+      },
+      {
+        type  = "field"
+        key   = "email_primary.email"
+        label = "Email"
+      },
+    ]
+  })
+}
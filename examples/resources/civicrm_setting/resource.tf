@@ -0,0 +1,18 @@
+# Send at most 500 mails per batch
+resource "civicrm_setting" "mailer_batch_limit" {
+  name  = "mailerBatchLimit"
+  value = jsonencode(500)
+}
+
+# Force HTTPS
+resource "civicrm_setting" "enable_ssl" {
+  name  = "enableSSL"
+  value = jsonencode(true)
+}
+
+# Setting of a second domain
+resource "civicrm_setting" "site_b_locale" {
+  name      = "lcMessages"
+  value     = jsonencode("de_DE")
+  domain_id = 2
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Kinds of errors that callers can check with errors.Is, e.g. to remove a
+// resource from the state when it was deleted outside of Terraform.
+var (
+	ErrNotFound         = errors.New("not found")
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrDuplicateEntry   = errors.New("duplicate entry")
+	ErrValidationFailed = errors.New("validation failed")
+)
+
+// APIError is returned by the client when CiviCRM rejects an API v4 request.
+// Kind is ErrPermissionDenied, ErrDuplicateEntry or ErrValidationFailed, or
+// nil when the error does not fit any of them.
+type APIError struct {
+	Entity     string
+	Action     string
+	StatusCode int
+	Code       int
+	Message    string
+	Kind       error
+}
+
+// entityRequirements names the component or extension that provides API
+// entities which are missing on servers where it is disabled.
+var entityRequirements = map[string]string{
+	"Campaign":          "the CiviCampaign component",
+	"CampaignGroup":     "the CiviCampaign component",
+	"Survey":            "the CiviCampaign component",
+	"Case":              "the CiviCase component",
+	"CaseType":          "the CiviCase component",
+	"Contribution":      "the CiviContribute component",
+	"ContributionPage":  "the CiviContribute component",
+	"ContributionRecur": "the CiviContribute component",
+	"Event":             "the CiviEvent component",
+	"Participant":       "the CiviEvent component",
+	"Grant":             "the CiviGrant extension",
+	"Mailing":           "the CiviMail component",
+	"MailingGroup":      "the CiviMail component",
+	"Membership":        "the CiviMember component",
+	"MembershipType":    "the CiviMember component",
+	"Pledge":            "the CiviPledge component",
+	"SearchDisplay":     "the SearchKit extension",
+	"Afform":            "the Form Builder (afform) extension",
+	"AfformSubmission":  "the Form Builder (afform) extension",
+	"OAuthClient":       "the OAuth extension",
+	"OAuthSysToken":     "the OAuth extension",
+	"OAuthContactToken": "the OAuth extension",
+}
+
+func (e *APIError) Error() string {
+	if e.IsUnsupported() {
+		requirement, ok := entityRequirements[e.Entity]
+		if !ok {
+			requirement = "a newer CiviCRM version or an extension that is not installed"
+		}
+		return fmt.Sprintf("the CiviCRM server does not support %s.%s, which requires %s (server message: %s)",
+			e.Entity, e.Action, requirement, e.Message)
+	}
+
+	switch e.Kind {
+	case ErrPermissionDenied:
+		return fmt.Sprintf("permission denied for %s.%s, check the permissions of the CiviCRM user the provider authenticates as (server message: %s)",
+			e.Entity, e.Action, e.Message)
+	case ErrDuplicateEntry:
+		return fmt.Sprintf("%s.%s failed because a record with the same unique values already exists, import it instead (server message: %s)",
+			e.Entity, e.Action, e.Message)
+	case ErrValidationFailed:
+		return fmt.Sprintf("CiviCRM rejected the values of %s.%s: %s", e.Entity, e.Action, e.Message)
+	}
+
+	if e.StatusCode != 0 && (e.StatusCode < 200 || e.StatusCode >= 300) {
+		return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, e.Message)
+	}
+
+	if e.Code == 0 {
+		return fmt.Sprintf("API error: %s", e.Message)
+	}
+	return fmt.Sprintf("API error %d: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the kind of the error, so that errors.Is(err, ErrNotFound)
+// and the like work for API errors.
+func (e *APIError) Unwrap() error {
+	return e.Kind
+}
+
+// IsUnsupported reports whether CiviCRM rejected the request because the
+// entity or action does not exist on the server, usually because the
+// component or extension that provides it is disabled.
+func (e *APIError) IsUnsupported() bool {
+	message := strings.ToLower(e.Message)
+	if strings.Contains(message, "not a valid entity") || strings.Contains(message, "entity not found") {
+		return true
+	}
+	return strings.HasPrefix(message, "api ") && strings.Contains(message, "does not exist")
+}
+
+// newAPIError builds an APIError from an API v4 response body. It returns nil
+// if the response does not carry an error.
+func newAPIError(entity, action string, statusCode int, apiResp *APIResponse) *APIError {
+	if apiResp.ErrorCode == 0 && apiResp.ErrorMessage == "" && statusCode >= 200 && statusCode < 300 {
+		return nil
+	}
+
+	message := apiResp.ErrorMessage
+	if message == "" {
+		message = http.StatusText(statusCode)
+	}
+
+	return buildAPIError(entity, action, statusCode, apiResp.ErrorCode, message)
+}
+
+// newRawAPIError builds an APIError from a response body that is not an API
+// response, e.g. the error page of a proxy or the CMS.
+func newRawAPIError(entity, action string, statusCode int, body string) *APIError {
+	return buildAPIError(entity, action, statusCode, 0, body)
+}
+
+// buildAPIError builds an APIError and classifies its kind.
+func buildAPIError(entity, action string, statusCode, code int, message string) *APIError {
+	apiErr := &APIError{
+		Entity:     entity,
+		Action:     action,
+		StatusCode: statusCode,
+		Code:       code,
+		Message:    message,
+	}
+	if !apiErr.IsUnsupported() {
+		apiErr.Kind = apiErrorKind(statusCode, message)
+	}
+	return apiErr
+}
+
+// apiErrorKind classifies an API error by its HTTP status and message. The
+// API does not report the cause of an error in a structured way, so the
+// messages of the exceptions CiviCRM throws are matched. Errors are never
+// classified as ErrNotFound: messages such as "Field 'x' does not exist"
+// point at the configuration rather than a missing record, and removing the
+// record from the state would let the next apply create a duplicate. The
+// client returns ErrNotFound itself when a lookup by ID comes back empty.
+func apiErrorKind(statusCode int, message string) error {
+	message = strings.ToLower(message)
+
+	switch {
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden,
+		strings.Contains(message, "authorization failed"),
+		strings.Contains(message, "permission denied"),
+		strings.Contains(message, "access denied"):
+		return ErrPermissionDenied
+	case strings.Contains(message, "already exists"),
+		strings.Contains(message, "duplicate entry"),
+		strings.Contains(message, "constraint violation"):
+		return ErrDuplicateEntry
+	case statusCode == http.StatusBadRequest || statusCode == http.StatusUnprocessableEntity,
+		strings.Contains(message, "mandatory values missing"),
+		strings.Contains(message, "is required"),
+		strings.Contains(message, "invalid"),
+		strings.Contains(message, "is not a valid"):
+		return ErrValidationFailed
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAPIErrorKind(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		message    string
+		want       error
+	}{
+		{name: "unauthorized", statusCode: http.StatusUnauthorized, message: "", want: ErrPermissionDenied},
+		{name: "forbidden", statusCode: http.StatusForbidden, message: "Forbidden", want: ErrPermissionDenied},
+		{name: "authorization failed", statusCode: http.StatusInternalServerError, message: "Authorization failed", want: ErrPermissionDenied},
+		{name: "access denied", statusCode: http.StatusOK, message: "Access denied to Group", want: ErrPermissionDenied},
+		{name: "already exists", statusCode: http.StatusInternalServerError, message: "DB Error: already exists", want: ErrDuplicateEntry},
+		{name: "duplicate entry", statusCode: http.StatusInternalServerError, message: "Duplicate entry 'volunteers' for key 'UI_name'", want: ErrDuplicateEntry},
+		{name: "constraint violation", statusCode: http.StatusInternalServerError, message: "DB Error: constraint violation", want: ErrDuplicateEntry},
+		{name: "bad request", statusCode: http.StatusBadRequest, message: "Bad Request", want: ErrValidationFailed},
+		{name: "unprocessable", statusCode: http.StatusUnprocessableEntity, message: "", want: ErrValidationFailed},
+		{name: "mandatory values", statusCode: http.StatusInternalServerError, message: "Mandatory values missing from Api4 Group::create: name", want: ErrValidationFailed},
+		{name: "invalid value", statusCode: http.StatusInternalServerError, message: "'Sometimes' is not a valid option for field visibility", want: ErrValidationFailed},
+		{name: "missing field", statusCode: http.StatusInternalServerError, message: "Field 'frontend_titel' does not exist", want: nil},
+		{name: "record not found", statusCode: http.StatusInternalServerError, message: "Group with id 42 not found", want: nil},
+		{name: "deadlock", statusCode: http.StatusInternalServerError, message: "DB Error: Deadlock found when trying to get lock", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := apiErrorKind(tt.statusCode, tt.message); got != tt.want {
+				t.Errorf("apiErrorKind() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewAPIError(t *testing.T) {
+	tests := []struct {
+		name       string
+		entity     string
+		statusCode int
+		apiResp    APIResponse
+		wantNil    bool
+		wantKind   error
+	}{
+		{name: "success", entity: "Group", statusCode: http.StatusOK, wantNil: true},
+		{name: "duplicate", entity: "Group", statusCode: http.StatusInternalServerError, apiResp: APIResponse{ErrorMessage: "DB Error: already exists"}, wantKind: ErrDuplicateEntry},
+		{name: "status only", entity: "Group", statusCode: http.StatusForbidden, wantKind: ErrPermissionDenied},
+		{name: "error code on success status", entity: "Group", statusCode: http.StatusOK, apiResp: APIResponse{ErrorCode: 1, ErrorMessage: "Something went wrong"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiResp := tt.apiResp
+			apiErr := newAPIError(tt.entity, "get", tt.statusCode, &apiResp)
+			if tt.wantNil {
+				if apiErr != nil {
+					t.Fatalf("newAPIError() = %v, want nil", apiErr)
+				}
+				return
+			}
+			if apiErr == nil {
+				t.Fatal("newAPIError() = nil, want an error")
+			}
+
+			if apiErr.IsUnsupported() {
+				t.Errorf("IsUnsupported() = true, want false")
+			}
+			if apiErr.Kind != tt.wantKind {
+				t.Errorf("Kind = %v, want %v", apiErr.Kind, tt.wantKind)
+			}
+			if tt.wantKind != nil && !errors.Is(apiErr, tt.wantKind) {
+				t.Errorf("errors.Is(%v, %v) = false", apiErr, tt.wantKind)
+			}
+			if errors.Is(apiErr, ErrNotFound) {
+				t.Errorf("errors.Is(%v, ErrNotFound) = true, API errors are never classified as not found", apiErr)
+			}
+		})
+	}
+}
+
+func TestAPIErrorUnsupported(t *testing.T) {
+	tests := []struct {
+		name        string
+		entity      string
+		message     string
+		requirement string
+	}{
+		{name: "disabled component", entity: "Event", message: "API (Event, get) does not exist (join the API team and implement it!)", requirement: "the CiviEvent component"},
+		{name: "invalid entity", entity: "Grant", message: "Grant is not a valid entity.", requirement: "the CiviGrant extension"},
+		{name: "entity not found", entity: "SearchDisplay", message: "Entity not found: SearchDisplay", requirement: "the SearchKit extension"},
+		{name: "unknown entity", entity: "Petition", message: "Petition is not a valid entity.", requirement: "a newer CiviCRM version or an extension that is not installed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiErr := newAPIError(tt.entity, "get", http.StatusInternalServerError, &APIResponse{ErrorMessage: tt.message})
+			if apiErr == nil {
+				t.Fatal("newAPIError() = nil, want an error")
+			}
+			if !apiErr.IsUnsupported() {
+				t.Errorf("IsUnsupported() = false, want true")
+			}
+			if apiErr.Kind != nil {
+				t.Errorf("Kind = %v, want nil", apiErr.Kind)
+			}
+			if !strings.Contains(apiErr.Error(), "which requires "+tt.requirement) {
+				t.Errorf("Error() = %q, want it to name %s", apiErr.Error(), tt.requirement)
+			}
+		})
+	}
+}
+
+func TestSearchDisplayResourceUnsupported(t *testing.T) {
+	ctx := context.Background()
+
+	client := newTestClient(t, func(req testRequest) ([]map[string]any, error) {
+		return nil, errors.New("API (SearchDisplay, get) does not exist (join the API team and implement it!)")
+	})
+
+	r := &SearchDisplayResource{}
+	s := newTestResource(t, r, client)
+
+	state := testState(t, s, SearchDisplayResourceModel{
+		ID:            types.Int64Value(4),
+		SavedSearchID: types.Int64Value(2),
+		Name:          types.StringValue("volunteers_table"),
+		Label:         types.StringValue("Volunteers"),
+		Type:          types.StringValue("table"),
+		Settings:      types.StringNull(),
+		ACLBypass:     types.BoolValue(false),
+	})
+
+	readResp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, readResp)
+	if !readResp.Diagnostics.HasError() {
+		t.Fatal("Read succeeded, want an error")
+	}
+	detail := readResp.Diagnostics.Errors()[0].Detail()
+	if !strings.Contains(detail, "does not support SearchDisplay.get, which requires the SearchKit extension") {
+		t.Errorf("diagnostic detail = %q, want it to name the SearchKit extension", detail)
+	}
+}
@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// legacyOperators are the API v4 where operators that API v3 accepts as
+// {"OPERATOR": value} filters.
+var legacyOperators = map[string]bool{
+	"=": true, "!=": true, "<>": true, ">": true, ">=": true, "<": true, "<=": true,
+	"IN": true, "NOT IN": true, "LIKE": true, "NOT LIKE": true,
+	"BETWEEN": true, "NOT BETWEEN": true, "IS NULL": true, "IS NOT NULL": true,
+}
+
+// doLegacyTranslatedRequest performs an API v4 request through API v3, for
+// servers configured with api_version = "3". Only the get, create, update and
+// delete actions on plain fields can be translated: pseudo fields such as
+// "option_group_id:name", OR clauses and the special actions of API v4 are
+// rejected.
+func (c *Client) doLegacyTranslatedRequest(ctx context.Context, entity, action string, params map[string]any) (*APIResponse, error) {
+	legacyParams := map[string]any{
+		"sequential": 1,
+	}
+	legacyAction := action
+
+	switch action {
+	case "get":
+		if err := addLegacyWhere(legacyParams, params["where"]); err != nil {
+			return nil, c.legacyTranslationError(entity, action, err)
+		}
+
+		options := map[string]any{"limit": 0}
+		if limit, ok := params["limit"]; ok {
+			options["limit"] = limit
+		}
+		if offset, ok := params["offset"]; ok {
+			options["offset"] = offset
+		}
+		if sort := legacySort(params["orderBy"]); sort != "" {
+			options["sort"] = sort
+		}
+		legacyParams["options"] = options
+
+		if selected, ok := params["select"].([]string); ok {
+			returnFields, err := legacyReturn(selected)
+			if err != nil {
+				return nil, c.legacyTranslationError(entity, action, err)
+			}
+			if len(returnFields) > 0 {
+				legacyParams["return"] = returnFields
+			}
+		}
+
+	case "create":
+		values, _ := params["values"].(map[string]any)
+		for k, v := range values {
+			legacyParams[k] = v
+		}
+
+	case "update", "delete":
+		id, err := legacyID(params["where"])
+		if err != nil {
+			return nil, c.legacyTranslationError(entity, action, err)
+		}
+		legacyParams["id"] = id
+
+		if action == "update" {
+			// API v3 updates through create when an id is given
+			legacyAction = "create"
+			values, _ := params["values"].(map[string]any)
+			for k, v := range values {
+				legacyParams[k] = v
+			}
+		}
+
+	default:
+		return nil, c.legacyTranslationError(entity, action, fmt.Errorf("the action has no API v3 equivalent"))
+	}
+
+	encoded, err := json.Marshal(legacyParams)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal params: %w", err)
+	}
+
+	values, err := c.doLegacyRequest(ctx, entity, legacyAction, map[string]string{
+		"json": string(encoded),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// A select of row_count asks for the number of matches only
+	if selected, ok := params["select"].([]string); ok && len(selected) == 1 && selected[0] == "row_count" {
+		return &APIResponse{Version: 3, Count: len(values)}, nil
+	}
+
+	return &APIResponse{Version: 3, Count: len(values), Values: values}, nil
+}
+
+// legacyTranslationError explains why a request cannot be sent through API v3.
+func (c *Client) legacyTranslationError(entity, action string, err error) error {
+	return fmt.Errorf("%s.%s is not available with api_version = \"3\": %w", entity, action, err)
+}
+
+// addLegacyWhere adds the API v4 where clauses as API v3 filter parameters.
+func addLegacyWhere(legacyParams map[string]any, where any) error {
+	clauses, _ := where.([][]any)
+	for _, clause := range clauses {
+		if len(clause) < 2 {
+			return fmt.Errorf("unsupported where clause %v", clause)
+		}
+
+		field, ok := clause[0].(string)
+		if !ok || field == "OR" || field == "AND" || field == "NOT" {
+			return fmt.Errorf("nested where clauses are not supported")
+		}
+		if strings.Contains(field, ":") || strings.Contains(field, ".") {
+			return fmt.Errorf("field %q is not supported", field)
+		}
+		if _, exists := legacyParams[field]; exists {
+			return fmt.Errorf("more than one condition on field %q is not supported", field)
+		}
+
+		operator, _ := clause[1].(string)
+		if !legacyOperators[operator] {
+			return fmt.Errorf("operator %q is not supported", operator)
+		}
+
+		switch {
+		case operator == "=":
+			legacyParams[field] = clause[2]
+		case operator == "IS NULL" || operator == "IS NOT NULL":
+			legacyParams[field] = map[string]any{operator: 1}
+		case len(clause) > 2:
+			legacyParams[field] = map[string]any{operator: clause[2]}
+		default:
+			return fmt.Errorf("operator %q requires a value", operator)
+		}
+	}
+
+	return nil
+}
+
+// legacyReturn converts an API v4 select to the return fields of API v3. An
+// empty result returns all fields.
+func legacyReturn(selected []string) ([]string, error) {
+	var fields []string
+	for _, field := range selected {
+		switch {
+		case field == "*":
+			return nil, nil
+		case field == "row_count":
+			fields = append(fields, "id")
+		case strings.Contains(field, ":") || strings.Contains(field, "."):
+			return nil, fmt.Errorf("field %q is not supported", field)
+		default:
+			fields = append(fields, field)
+		}
+	}
+	return fields, nil
+}
+
+// legacySort converts an API v4 orderBy to the sort option of API v3.
+func legacySort(orderBy any) string {
+	var parts []string
+	switch o := orderBy.(type) {
+	case orderByFields:
+		for _, field := range o {
+			parts = append(parts, field+" ASC")
+		}
+	case map[string]string:
+		for field, direction := range o {
+			parts = append(parts, field+" "+direction)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// legacyID returns the ID of an update or delete request, which API v3 only
+// supports for a single entity selected by ID.
+func legacyID(where any) (any, error) {
+	clauses, _ := where.([][]any)
+	if len(clauses) != 1 || len(clauses[0]) != 3 || clauses[0][0] != "id" || clauses[0][1] != "=" {
+		return nil, fmt.Errorf("only a single entity selected by id can be changed")
+	}
+	return clauses[0][2], nil
+}
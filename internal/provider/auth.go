@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Authentication methods of the auth_type provider attribute.
+const (
+	authTypeAPIKey = "api_key"
+	authTypeBasic  = "basic"
+	authTypeJWT    = "jwt"
+	authTypeOAuth2 = "oauth2"
+)
+
+// authenticator adds the credentials of the configured authentication method
+// to a request.
+type authenticator interface {
+	authenticate(req *http.Request) error
+}
+
+// apiKeyAuth sends an API key of a CiviCRM user. Without a header name the
+// key is sent as "Authorization: Bearer <key>", which AuthX accepts for API
+// keys; otherwise the raw key is sent under the given header.
+//
+// With a site key, the classic pair of the REST interface is sent instead:
+// the api_key and key query parameters. The site key is also sent as
+// X-Civi-Key for sites where AuthX guards API keys with the site key.
+type apiKeyAuth struct {
+	key     string
+	header  string
+	siteKey string
+}
+
+func (a apiKeyAuth) authenticate(req *http.Request) error {
+	switch {
+	case a.siteKey != "":
+		query := req.URL.Query()
+		query.Set("api_key", a.key)
+		query.Set("key", a.siteKey)
+		req.URL.RawQuery = query.Encode()
+		req.Header.Set("X-Civi-Key", a.siteKey)
+	case a.header != "":
+		req.Header.Set(a.header, a.key)
+	default:
+		req.Header.Set("Authorization", "Bearer "+a.key)
+	}
+	return nil
+}
+
+// basicAuth sends the CMS username and password of a user with the Basic
+// scheme of AuthX. The X-Civi-Auth header is used instead of Authorization,
+// because web servers and CMSs often consume the Authorization header for
+// their own Basic authentication.
+type basicAuth struct {
+	username string
+	password string
+}
+
+func (a basicAuth) authenticate(req *http.Request) error {
+	credentials := base64.StdEncoding.EncodeToString([]byte(a.username + ":" + a.password))
+	req.Header.Set("X-Civi-Auth", "Basic "+credentials)
+	return nil
+}
+
+// defaultJWTLifetime is the lifetime of the tokens minted by jwtAuth.
+const defaultJWTLifetime = 5 * time.Minute
+
+// jwtAuth sends a JSON Web Token as "Authorization: Bearer <jwt>", which AuthX
+// accepts as an alternative to API keys. It either sends a token minted
+// elsewhere, or mints HS256 tokens for a contact with signingKey and replaces
+// them before they expire.
+type jwtAuth struct {
+	token string
+
+	signingKey []byte
+	contactID  int64
+	issuer     string
+	lifetime   time.Duration
+
+	mu      sync.Mutex
+	minted  string
+	expires time.Time
+}
+
+func (a *jwtAuth) authenticate(req *http.Request) error {
+	token := a.token
+	if a.signingKey != nil {
+		var err error
+		token, err = a.currentToken()
+		if err != nil {
+			return err
+		}
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// currentToken returns the minted token, minting a new one when it has
+// expired or is about to. A fifth of the lifetime is kept as margin, so that
+// slow requests and clock skew do not let a token expire in flight.
+func (a *jwtAuth) currentToken() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := time.Now()
+	if a.minted != "" && now.Add(a.lifetime/5).Before(a.expires) {
+		return a.minted, nil
+	}
+
+	expires := now.Add(a.lifetime)
+	claims := map[string]any{
+		"sub":   "cid:" + strconv.FormatInt(a.contactID, 10),
+		"scope": "authx",
+		"iat":   now.Unix(),
+		"exp":   expires.Unix(),
+	}
+	if a.issuer != "" {
+		claims["iss"] = a.issuer
+	}
+
+	token, err := signJWT(claims, a.signingKey)
+	if err != nil {
+		return "", err
+	}
+
+	a.minted = token
+	a.expires = expires
+	return token, nil
+}
+
+// signJWT encodes claims as a JSON Web Token signed with HMAC-SHA256.
+func signJWT(claims map[string]any, key []byte) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	encoding := base64.RawURLEncoding
+	unsigned := encoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`)) + "." + encoding.EncodeToString(payload)
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + encoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// oauth2Auth sends an access token obtained with the OAuth2 client
+// credentials grant, for sites behind an OAuth2 gateway. The token is cached
+// and a new one is requested shortly before it expires.
+type oauth2Auth struct {
+	tokenURL     string
+	clientID     string
+	clientSecret string
+	scopes       []string
+	httpClient   *http.Client
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// oauth2TokenResponse is the successful response of a token endpoint.
+type oauth2TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// oauth2ExpiryMargin is how long before its expiry a token is replaced, so
+// that slow requests and clock skew do not let it expire in flight.
+const oauth2ExpiryMargin = 30 * time.Second
+
+func (a *oauth2Auth) authenticate(req *http.Request) error {
+	token, err := a.currentToken(req.Context())
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// currentToken returns the cached access token, requesting a new one when
+// none was requested yet or it is about to expire. Tokens without an
+// expires_in are kept for the lifetime of the provider.
+func (a *oauth2Auth) currentToken(ctx context.Context) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.token != "" && (a.expires.IsZero() || time.Now().Add(oauth2ExpiryMargin).Before(a.expires)) {
+		return a.token, nil
+	}
+
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(a.scopes) > 0 {
+		form.Set("scope", strings.Join(a.scopes, " "))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.SetBasicAuth(url.QueryEscape(a.clientID), url.QueryEscape(a.clientSecret))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("token request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var tokenResp oauth2TokenResponse
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return "", fmt.Errorf("failed to parse token response: %w", err)
+	}
+	if tokenResp.AccessToken == "" {
+		return "", fmt.Errorf("token response contains no access_token")
+	}
+	if tokenResp.TokenType != "" && !strings.EqualFold(tokenResp.TokenType, "bearer") {
+		return "", fmt.Errorf("unsupported token type '%s'", tokenResp.TokenType)
+	}
+
+	a.token = tokenResp.AccessToken
+	a.expires = time.Time{}
+	if tokenResp.ExpiresIn > 0 {
+		a.expires = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+	}
+	return a.token, nil
+}
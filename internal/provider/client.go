@@ -2,21 +2,67 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
+	"mime"
+	"mime/multipart"
+	"net"
 	"net/http"
 	"net/url"
+	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Client is the CiviCRM API v4 HTTP client
 type Client struct {
-	baseURL    string
-	apiKey     string
+	baseURL string
+	auth    authenticator
+
+	// restPath is put between baseURL and the CiviCRM route of a request,
+	// see endpointStyles
+	restPath string
+
+	// userAgent and extraHeaders are sent with every request, see setHeaders
+	userAgent    string
+	extraHeaders map[string]string
+
 	httpClient *http.Client
+	transport  *http.Transport
+
+	// maxRetries and retryWait control how transient failures are retried,
+	// see send
+	maxRetries int
+	retryWait  time.Duration
+
+	// requestTimeout limits each attempt of a request, including reading
+	// the response
+	requestTimeout time.Duration
+
+	// apiVersion is 4, or 3 for servers that only expose API v3, see
+	// doLegacyTranslatedRequest
+	apiVersion int
+
+	// limiter throttles requests when a rate limit is configured, nil
+	// otherwise
+	limiter *rateLimiter
+
+	// treatEmptyAsNull maps empty optional strings returned by the API to
+	// null, see optionalString
+	treatEmptyAsNull bool
+
+	// optionGroupIDs caches the IDs of option groups by name, see
+	// GetOptionGroupID
+	optionGroupMu  sync.Mutex
+	optionGroupIDs map[string]int64
 }
 
 // APIResponse represents the standard CiviCRM API v4 response
@@ -28,36 +74,126 @@ type APIResponse struct {
 	ErrorMessage string           `json:"error_message,omitempty"`
 }
 
-// NewClient creates a new CiviCRM API client
-func NewClient(baseURL, apiKey string, insecure bool) (*Client, error) {
+// NewClient creates a new CiviCRM API client.
+// If apiKeyHeader is empty, the API key is sent as "Authorization: Bearer <key>".
+// Otherwise the raw key is sent under the given header name.
+func NewClient(baseURL, apiKey, apiKeyHeader string, insecure bool) (*Client, error) {
 	// Normalize the base URL
 	baseURL = strings.TrimSuffix(baseURL, "/")
 
+	// Terraform runs up to 10 operations in parallel, all against the same
+	// host. The default of 2 idle connections per host would make most of
+	// them open a new connection, with a TLS handshake, for every request.
+	// Responses are requested with gzip and decompressed transparently by the
+	// transport, as long as no request sets Accept-Encoding itself.
 	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
 		TLSClientConfig: &tls.Config{
 			InsecureSkipVerify: insecure,
 		},
+		// A custom TLS config disables HTTP/2 unless forced
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          maxIdleConnections,
+		MaxIdleConnsPerHost:   maxIdleConnections,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: time.Second,
 	}
 
+	// Requests are bounded by a context deadline per attempt instead of
+	// http.Client.Timeout, see sendOnce
 	httpClient := &http.Client{
 		Transport: transport,
-		Timeout:   30 * time.Second,
 	}
 
 	return &Client{
-		baseURL:    baseURL,
-		apiKey:     apiKey,
-		httpClient: httpClient,
+		baseURL:          baseURL,
+		restPath:         endpointStyles[defaultEndpointStyle],
+		userAgent:        userAgentName,
+		auth:             apiKeyAuth{key: apiKey, header: apiKeyHeader},
+		httpClient:       httpClient,
+		transport:        transport,
+		maxRetries:       defaultMaxRetries,
+		retryWait:        defaultRetryWait,
+		requestTimeout:   defaultRequestTimeout,
+		apiVersion:       4,
+		treatEmptyAsNull: true,
 	}, nil
 }
 
+// maxIdleConnections is the number of connections kept open for reuse. It
+// covers the default parallelism of Terraform with room for retries.
+const maxIdleConnections = 16
+
+// defaultRequestTimeout is long enough for most API calls. Creating custom
+// fields alters database tables, which can take minutes on large sites, so
+// the timeout is configurable.
+const defaultRequestTimeout = 30 * time.Second
+
+// endpointStyles maps the endpoint_style presets to the rest path of the
+// CMS. Drupal, Backdrop and Standalone route clean URLs, WordPress and Joomla
+// pass the CiviCRM route as a query parameter of their admin pages.
+var endpointStyles = map[string]string{
+	"drupal":     "/",
+	"backdrop":   "/",
+	"standalone": "/",
+	"wordpress":  "/wp-admin/admin.php?page=CiviCRM&q=",
+	"joomla":     "/administrator/index.php?option=com_civicrm&task=",
+}
+
+// defaultEndpointStyle is the endpoint style used when none is configured.
+const defaultEndpointStyle = "drupal"
+
+// endpoint returns the URL of a CiviCRM route such as "civicrm/ajax/rest".
+func (c *Client) endpoint(route string) string {
+	return c.baseURL + c.restPath + route
+}
+
 // buildEndpoint constructs the API endpoint URL
 func (c *Client) buildEndpoint(entity, action string) string {
-	return fmt.Sprintf("%s/civicrm/ajax/api4/%s/%s", c.baseURL, entity, action)
+	return c.endpoint(fmt.Sprintf("civicrm/ajax/api4/%s/%s", entity, action))
+}
+
+// userAgentName identifies the provider in the User-Agent header. The
+// provider version is appended when known.
+const userAgentName = "terraform-provider-civicrm"
+
+// setHeaders adds the User-Agent, the configured extra headers, the
+// credentials and the AJAX headers CiviCRM expects on every API request. The
+// credentials are added last, so that extra headers cannot replace them.
+func (c *Client) setHeaders(req *http.Request) error {
+	req.Header.Set("User-Agent", c.userAgent)
+	for name, value := range c.extraHeaders {
+		req.Header.Set(name, value)
+	}
+
+	if err := c.auth.authenticate(req); err != nil {
+		return fmt.Errorf("failed to authenticate: %w", err)
+	}
+	req.Header.Set("X-Requested-With", "XMLHttpRequest")
+	return nil
 }
 
-// doRequest performs an HTTP request to the CiviCRM API
-func (c *Client) doRequest(method, endpoint string, params map[string]any) (*APIResponse, error) {
+// doRequest performs an HTTP request to the CiviCRM API. Errors reported by
+// CiviCRM are returned as *APIError.
+func (c *Client) doRequest(ctx context.Context, method, entity, action string, params map[string]any) (*APIResponse, error) {
+	if entity == "OptionGroup" && action != "get" {
+		c.forgetOptionGroupIDs()
+	}
+
+	if c.apiVersion == 3 {
+		return c.doLegacyTranslatedRequest(ctx, entity, action, params)
+	}
+
+	ctx = tflog.SetField(ctx, "civicrm_entity", entity)
+	ctx = tflog.SetField(ctx, "civicrm_action", action)
+
+	endpoint := c.buildEndpoint(entity, action)
+
 	// Encode parameters as JSON
 	paramsJSON, err := json.Marshal(params)
 	if err != nil {
@@ -68,64 +204,63 @@ func (c *Client) doRequest(method, endpoint string, params map[string]any) (*API
 	formData := url.Values{}
 	formData.Set("params", string(paramsJSON))
 
-	var req *http.Request
-	if method == http.MethodGet {
-		reqURL := endpoint + "?" + formData.Encode()
-		req, err = http.NewRequest(method, reqURL, nil)
-	} else {
-		req, err = http.NewRequest(method, endpoint, bytes.NewBufferString(formData.Encode()))
-	}
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	// Set headers
-	req.Header.Set("Authorization", "Bearer "+c.apiKey)
-	req.Header.Set("X-Requested-With", "XMLHttpRequest")
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	req.Header.Set("Accept", "application/json")
-
 	// Execute request
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
-	}
-	defer resp.Body.Close()
+	statusCode, body, err := c.send(ctx, action, func() (*http.Request, error) {
+		var req *http.Request
+		var err error
+		if method == http.MethodGet {
+			// The WordPress and Joomla endpoints already have a query
+			separator := "?"
+			if strings.Contains(endpoint, "?") {
+				separator = "&"
+			}
+			reqURL := endpoint + separator + formData.Encode()
+			req, err = http.NewRequestWithContext(ctx, method, reqURL, nil)
+		} else {
+			req, err = http.NewRequestWithContext(ctx, method, endpoint, strings.NewReader(formData.Encode()))
+		}
+		if err != nil {
+			return nil, err
+		}
 
-	// Read response body
-	body, err := io.ReadAll(resp.Body)
+		// Set headers
+		if err := c.setHeaders(req); err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("Accept", "application/json")
+		return req, nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	// Check for HTTP errors
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		return nil, err
 	}
 
-	// Parse response
+	// Parse response. CiviCRM also reports errors with a JSON body, so HTTP
+	// errors only carry the raw text when the body cannot be parsed, e.g.
+	// when a proxy or the CMS rejected the request.
 	var apiResp APIResponse
 	if err := json.Unmarshal(body, &apiResp); err != nil {
+		if statusCode < 200 || statusCode >= 300 {
+			return nil, newRawAPIError(entity, action, statusCode, string(body))
+		}
 		return nil, fmt.Errorf("failed to parse response: %w, body: %s", err, string(body))
 	}
 
 	// Check for API errors
-	if apiResp.ErrorCode != 0 || apiResp.ErrorMessage != "" {
-		return nil, fmt.Errorf("API error %d: %s", apiResp.ErrorCode, apiResp.ErrorMessage)
+	if apiErr := newAPIError(entity, action, statusCode, &apiResp); apiErr != nil {
+		return nil, apiErr
 	}
 
 	return &apiResp, nil
 }
 
 // Create creates a new entity
-func (c *Client) Create(entity string, values map[string]any) (map[string]any, error) {
-	endpoint := c.buildEndpoint(entity, "create")
-
+func (c *Client) Create(ctx context.Context, entity string, values map[string]any) (map[string]any, error) {
 	params := map[string]any{
 		"values": values,
 	}
 
-	resp, err := c.doRequest(http.MethodPost, endpoint, params)
+	resp, err := c.doRequest(ctx, http.MethodPost, entity, "create", params)
 	if err != nil {
 		return nil, err
 	}
@@ -137,47 +272,236 @@ func (c *Client) Create(entity string, values map[string]any) (map[string]any, e
 	return resp.Values[0], nil
 }
 
-// Get retrieves entities by ID or filter
-func (c *Client) Get(entity string, where [][]any, select_ []string) ([]map[string]any, error) {
-	endpoint := c.buildEndpoint(entity, "get")
+// APIChain is an API v4 call chained to the records of another call. String
+// values of the form "$field" in Params are replaced with that field of the
+// parent record, e.g. "$id".
+type APIChain struct {
+	Entity string
+	Action string
+	Params map[string]any
+}
+
+// MarshalJSON encodes the chain in the [entity, action, params] form of the
+// API.
+func (a APIChain) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{a.Entity, a.Action, a.Params})
+}
+
+// CreateWithChain creates an entity and runs the chained calls for it in the
+// same request, so that a failed connection cannot leave related records
+// half-created. The results of each chain are returned under its name, see
+// ChainResults. In API v3 mode, the chains are run as separate requests.
+func (c *Client) CreateWithChain(ctx context.Context, entity string, values map[string]any, chains map[string]APIChain) (map[string]any, error) {
+	if c.apiVersion == 3 {
+		return c.createWithLegacyChain(ctx, entity, values, chains)
+	}
 
 	params := map[string]any{
-		"where": where,
+		"values": values,
+		"chain":  chains,
 	}
-	if len(select_) > 0 {
-		params["select"] = select_
+
+	resp, err := c.doRequest(ctx, http.MethodPost, entity, "create", params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Values) == 0 {
+		return nil, fmt.Errorf("no values returned from create operation")
 	}
 
-	resp, err := c.doRequest(http.MethodPost, endpoint, params)
+	return resp.Values[0], nil
+}
+
+// createWithLegacyChain emulates CreateWithChain with one request per call,
+// as API v3 cannot chain API v4 calls.
+func (c *Client) createWithLegacyChain(ctx context.Context, entity string, values map[string]any, chains map[string]APIChain) (map[string]any, error) {
+	result, err := c.Create(ctx, entity, values)
 	if err != nil {
 		return nil, err
 	}
 
-	return resp.Values, nil
+	for name, chain := range chains {
+		params, _ := substituteChainValues(chain.Params, result).(map[string]any)
+		resp, err := c.doRequest(ctx, http.MethodPost, chain.Entity, chain.Action, params)
+		if err != nil {
+			return nil, fmt.Errorf("chain %s: %w", name, err)
+		}
+
+		records := make([]any, len(resp.Values))
+		for i, record := range resp.Values {
+			records[i] = record
+		}
+		result[name] = records
+	}
+
+	return result, nil
+}
+
+// substituteChainValues replaces "$field" strings in value with the fields
+// of the parent record, like the API does for chains.
+func substituteChainValues(value any, parent map[string]any) any {
+	switch v := value.(type) {
+	case string:
+		if field, ok := strings.CutPrefix(v, "$"); ok {
+			if parentValue, ok := parent[field]; ok {
+				return parentValue
+			}
+		}
+		return v
+	case map[string]any:
+		substituted := make(map[string]any, len(v))
+		for k, item := range v {
+			substituted[k] = substituteChainValues(item, parent)
+		}
+		return substituted
+	case []any:
+		substituted := make([]any, len(v))
+		for i, item := range v {
+			substituted[i] = substituteChainValues(item, parent)
+		}
+		return substituted
+	case [][]any:
+		substituted := make([][]any, len(v))
+		for i, item := range v {
+			substituted[i], _ = substituteChainValues(item, parent).([]any)
+		}
+		return substituted
+	default:
+		return v
+	}
+}
+
+// ChainResults returns the records a chain returned for result.
+func ChainResults(result map[string]any, name string) []map[string]any {
+	items, _ := result[name].([]any)
+
+	records := make([]map[string]any, 0, len(items))
+	for _, item := range items {
+		if record, ok := item.(map[string]any); ok {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+// Get retrieves entities by ID or filter, paging through all matches like
+// GetAll
+func (c *Client) Get(ctx context.Context, entity string, where [][]any, select_ []string) ([]map[string]any, error) {
+	return c.GetAll(ctx, entity, where, select_, nil)
+}
+
+// getAllPageSize is the number of records GetAll requests per page.
+const getAllPageSize = 100
+
+// getAllMaxRecords caps the number of records GetAll reads, so that a missing
+// filter fails instead of paging through a whole database.
+const getAllMaxRecords = 100000
+
+// orderByFields marshals to an API v4 orderBy object that sorts ascending by
+// the fields in the given order. A map would lose the order of the fields.
+type orderByFields []string
+
+func (o orderByFields) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, field := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(field)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteString(`:"ASC"`)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// GetAll retrieves all entities matching the filter, requesting them page by
+// page so that large result sets are not cut off by a server-side limit.
+// Results are sorted ascending by the orderBy fields and then by id
+func (c *Client) GetAll(ctx context.Context, entity string, where [][]any, select_ []string, orderBy []string) ([]map[string]any, error) {
+	return c.GetLimited(ctx, entity, where, select_, orderBy, 0)
+}
+
+// GetLimited is GetAll, but stops after limit records. A limit of 0 reads all
+// records, up to getAllMaxRecords.
+func (c *Client) GetLimited(ctx context.Context, entity string, where [][]any, select_ []string, orderBy []string, limit int) ([]map[string]any, error) {
+	// Sorting by id last keeps the pages stable
+	order := orderByFields(orderBy)
+	if !slices.Contains(order, "id") {
+		order = append(order[:len(order):len(order)], "id")
+	}
+
+	var all []map[string]any
+	for offset := 0; ; offset += getAllPageSize {
+		pageSize := getAllPageSize
+		if limit > 0 {
+			pageSize = min(pageSize, limit-offset)
+		} else if offset >= getAllMaxRecords {
+			return nil, fmt.Errorf("more than %d %s records match, narrow down the filters", getAllMaxRecords, entity)
+		}
+
+		params := map[string]any{
+			"where":   where,
+			"orderBy": order,
+			"limit":   pageSize,
+			"offset":  offset,
+		}
+		if len(select_) > 0 {
+			params["select"] = select_
+		}
+
+		resp, err := c.doRequest(ctx, http.MethodPost, entity, "get", params)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, resp.Values...)
+		if len(resp.Values) < pageSize || len(all) == limit {
+			return all, nil
+		}
+	}
 }
 
 // GetByID retrieves a single entity by ID
-func (c *Client) GetByID(entity string, id int64, select_ []string) (map[string]any, error) {
+func (c *Client) GetByID(ctx context.Context, entity string, id int64, select_ []string) (map[string]any, error) {
 	where := [][]any{
 		{"id", "=", id},
 	}
 
-	results, err := c.Get(entity, where, select_)
+	results, err := c.Get(ctx, entity, where, select_)
 	if err != nil {
 		return nil, err
 	}
 
 	if len(results) == 0 {
-		return nil, fmt.Errorf("%s with ID %d not found", entity, id)
+		return nil, fmt.Errorf("%s with ID %d %w", entity, id, ErrNotFound)
 	}
 
 	return results[0], nil
 }
 
-// Update updates an existing entity
-func (c *Client) Update(entity string, id int64, values map[string]any) (map[string]any, error) {
-	endpoint := c.buildEndpoint(entity, "update")
+// Count returns the number of entities matching where
+func (c *Client) Count(ctx context.Context, entity string, where [][]any) (int64, error) {
+	params := map[string]any{
+		"where":  where,
+		"select": []string{"row_count"},
+	}
 
+	resp, err := c.doRequest(ctx, http.MethodPost, entity, "get", params)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(resp.Count), nil
+}
+
+// Update updates an existing entity
+func (c *Client) Update(ctx context.Context, entity string, id int64, values map[string]any) (map[string]any, error) {
 	params := map[string]any{
 		"where": [][]any{
 			{"id", "=", id},
@@ -185,7 +509,7 @@ func (c *Client) Update(entity string, id int64, values map[string]any) (map[str
 		"values": values,
 	}
 
-	resp, err := c.doRequest(http.MethodPost, endpoint, params)
+	resp, err := c.doRequest(ctx, http.MethodPost, entity, "update", params)
 	if err != nil {
 		return nil, err
 	}
@@ -197,17 +521,328 @@ func (c *Client) Update(entity string, id int64, values map[string]any) (map[str
 	return resp.Values[0], nil
 }
 
+// Save creates an entity, or updates the existing entity whose match fields
+// have the same values, with the API v4 save action. Without match fields,
+// entities are matched by name. This makes creates idempotent on sites that
+// were seeded with the same records.
+func (c *Client) Save(ctx context.Context, entity string, values map[string]any, match []string) (map[string]any, error) {
+	if len(match) == 0 {
+		match = []string{"name"}
+	}
+
+	for _, field := range match {
+		if _, ok := values[field]; !ok {
+			return nil, fmt.Errorf("cannot save %s: no value for match field '%s'", entity, field)
+		}
+	}
+
+	if c.apiVersion == 3 {
+		return c.saveLegacy(ctx, entity, values, match)
+	}
+
+	params := map[string]any{
+		"records": []map[string]any{values},
+		"match":   match,
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, entity, "save", params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Values) == 0 {
+		return nil, fmt.Errorf("no values returned from save operation")
+	}
+
+	return resp.Values[0], nil
+}
+
+// saveLegacy emulates Save with a lookup and a create or update, as API v3
+// has no save action with match fields.
+func (c *Client) saveLegacy(ctx context.Context, entity string, values map[string]any, match []string) (map[string]any, error) {
+	where := make([][]any, 0, len(match))
+	for _, field := range match {
+		where = append(where, []any{field, "=", values[field]})
+	}
+
+	existing, err := c.Get(ctx, entity, where, []string{"id"})
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(existing) {
+	case 0:
+		return c.Create(ctx, entity, values)
+	case 1:
+		id, ok := GetInt64(existing[0], "id")
+		if !ok {
+			return nil, fmt.Errorf("matching %s has no valid id", entity)
+		}
+		return c.Update(ctx, entity, id, values)
+	default:
+		return nil, fmt.Errorf("cannot save %s: %d records match %v", entity, len(existing), match)
+	}
+}
+
+// Replace makes records the complete set of entities matching where, with the
+// API v4 replace action: records with an id are updated, records without one
+// are created, and matching entities that are not in records are deleted.
+// The where clause usually names the owner of the collection, e.g. the option
+// group of option values. It must not be empty, as every entity would match
+// and be deleted. The saved records are returned.
+func (c *Client) Replace(ctx context.Context, entity string, where [][]any, records []map[string]any) ([]map[string]any, error) {
+	if len(where) == 0 {
+		return nil, fmt.Errorf("%s.replace requires a where clause, refusing to replace every %s", entity, entity)
+	}
+
+	if c.apiVersion == 3 {
+		return c.replaceLegacy(ctx, entity, where, records)
+	}
+
+	params := map[string]any{
+		"where":   where,
+		"records": records,
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, entity, "replace", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Values, nil
+}
+
+// replaceLegacy emulates Replace with one request per record, as API v3 has
+// no replace action. Unlike Replace, a failure can leave the collection
+// partially reconciled.
+func (c *Client) replaceLegacy(ctx context.Context, entity string, where [][]any, records []map[string]any) ([]map[string]any, error) {
+	existing, err := c.Get(ctx, entity, where, []string{"id"})
+	if err != nil {
+		return nil, err
+	}
+
+	saved := make([]map[string]any, 0, len(records))
+	kept := make(map[int64]bool, len(records))
+	for _, record := range records {
+		var result map[string]any
+		if id, ok := GetInt64(record, "id"); ok {
+			values := make(map[string]any, len(record))
+			for k, v := range record {
+				if k != "id" {
+					values[k] = v
+				}
+			}
+			result, err = c.Update(ctx, entity, id, values)
+			kept[id] = true
+		} else {
+			// Like the API, new records get the values of the = conditions,
+			// so that they belong to the collection
+			values := make(map[string]any, len(record)+len(where))
+			for _, condition := range where {
+				if len(condition) == 3 && condition[1] == "=" {
+					if field, ok := condition[0].(string); ok {
+						values[field] = condition[2]
+					}
+				}
+			}
+			for k, v := range record {
+				values[k] = v
+			}
+			result, err = c.Create(ctx, entity, values)
+		}
+		if err != nil {
+			return nil, err
+		}
+		saved = append(saved, result)
+	}
+
+	for _, record := range existing {
+		if id, ok := GetInt64(record, "id"); ok && !kept[id] {
+			if err := c.Delete(ctx, entity, id); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return saved, nil
+}
+
 // Delete deletes an entity by ID
-func (c *Client) Delete(entity string, id int64) error {
-	endpoint := c.buildEndpoint(entity, "delete")
+func (c *Client) Delete(ctx context.Context, entity string, id int64) error {
+	params := map[string]any{
+		"where": [][]any{
+			{"id", "=", id},
+		},
+	}
+
+	_, err := c.doRequest(ctx, http.MethodPost, entity, "delete", params)
+	return err
+}
 
+// SystemCheck runs the CiviCRM system checks and returns the status messages
+// that are not hidden by an administrator
+func (c *Client) SystemCheck(ctx context.Context) ([]map[string]any, error) {
+	params := map[string]any{
+		"where": [][]any{
+			{"is_visible", "=", true},
+		},
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, "System", "check", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Values, nil
+}
+
+// RefreshGroup rebuilds the contact cache of a smart group
+func (c *Client) RefreshGroup(ctx context.Context, id int64) error {
 	params := map[string]any{
 		"where": [][]any{
 			{"id", "=", id},
 		},
 	}
 
-	_, err := c.doRequest(http.MethodPost, endpoint, params)
+	_, err := c.doRequest(ctx, http.MethodPost, "Group", "refresh", params)
+	return err
+}
+
+// GetFields returns the field metadata of entity for the given action, with
+// the options of each field loaded as id, name and label
+func (c *Client) GetFields(ctx context.Context, entity, action string) ([]map[string]any, error) {
+	params := map[string]any{
+		"action":      action,
+		"loadOptions": []string{"id", "name", "label"},
+		"select": []string{
+			"name", "title", "label", "description", "type", "data_type",
+			"input_type", "required", "readonly", "options",
+		},
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, entity, "getFields", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Values, nil
+}
+
+// GetSystemInfo returns the versions of CiviCRM, the CMS, PHP and the
+// database. API v4 System.get only reports the CiviCRM version, so this uses
+// API v3
+func (c *Client) GetSystemInfo(ctx context.Context) (map[string]any, error) {
+	values, err := c.doLegacyRequest(ctx, "System", "get", map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no values returned from system info")
+	}
+
+	return values[0], nil
+}
+
+// settingParams returns the parameters shared by the Setting actions. A
+// domainID of 0 selects the current domain
+func settingParams(domainID int64) map[string]any {
+	params := map[string]any{}
+	if domainID != 0 {
+		params["domainId"] = domainID
+	}
+	return params
+}
+
+// GetSetting returns the current value of a setting
+func (c *Client) GetSetting(ctx context.Context, name string, domainID int64) (any, error) {
+	params := settingParams(domainID)
+	params["select"] = []string{name}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, "Setting", "get", params)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range resp.Values {
+		if n, _ := GetString(v, "name"); n == name {
+			return v["value"], nil
+		}
+	}
+
+	return nil, fmt.Errorf("setting '%s' %w", name, ErrNotFound)
+}
+
+// SetSetting changes the value of a setting
+func (c *Client) SetSetting(ctx context.Context, name string, value any, domainID int64) error {
+	params := settingParams(domainID)
+	params["values"] = map[string]any{name: value}
+
+	_, err := c.doRequest(ctx, http.MethodPost, "Setting", "set", params)
+	return err
+}
+
+// RevertSetting resets a setting to its default value
+func (c *Client) RevertSetting(ctx context.Context, name string, domainID int64) error {
+	params := settingParams(domainID)
+	params["select"] = []string{name}
+
+	_, err := c.doRequest(ctx, http.MethodPost, "Setting", "revert", params)
+	return err
+}
+
+// GetAfform returns the FormBuilder form with the given name, with its layout
+// as HTML
+func (c *Client) GetAfform(ctx context.Context, name string) (map[string]any, error) {
+	params := map[string]any{
+		"where": [][]any{
+			{"name", "=", name},
+		},
+		"layoutFormat": "html",
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, "Afform", "get", params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Values) == 0 {
+		return nil, fmt.Errorf("form '%s' %w", name, ErrNotFound)
+	}
+
+	return resp.Values[0], nil
+}
+
+// SaveAfform creates or replaces a FormBuilder form. The layout in values is
+// HTML
+func (c *Client) SaveAfform(ctx context.Context, values map[string]any) (map[string]any, error) {
+	params := map[string]any{
+		"records":      []map[string]any{values},
+		"layoutFormat": "html",
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, "Afform", "save", params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Values) == 0 {
+		return nil, fmt.Errorf("no values returned from save operation")
+	}
+
+	return resp.Values[0], nil
+}
+
+// RevertAfform removes the local copy of a FormBuilder form. Forms provided by
+// an extension return to their packaged version, all others are deleted
+func (c *Client) RevertAfform(ctx context.Context, name string) error {
+	params := map[string]any{
+		"where": [][]any{
+			{"name", "=", name},
+		},
+	}
+
+	_, err := c.doRequest(ctx, http.MethodPost, "Afform", "revert", params)
 	return err
 }
 
@@ -229,6 +864,10 @@ func GetInt64(m map[string]any, key string) (int64, bool) {
 	case json.Number:
 		i, err := val.Int64()
 		return i, err == nil
+	case string:
+		// API v3 returns numeric fields as strings
+		i, err := strconv.ParseInt(val, 10, 64)
+		return i, err == nil
 	default:
 		return 0, false
 	}
@@ -264,25 +903,305 @@ func GetBool(m map[string]any, key string) (bool, bool) {
 	}
 }
 
-// GetOptionGroupID retrieves the numeric ID of an option group by name
-func (c *Client) GetOptionGroupID(name string) (int64, error) {
+// GetOptionGroupID retrieves the numeric ID of an option group by name. IDs
+// are cached for the lifetime of the client, as every resource based on
+// option values looks up its option group on create.
+func (c *Client) GetOptionGroupID(ctx context.Context, name string) (int64, error) {
+	c.optionGroupMu.Lock()
+	id, ok := c.optionGroupIDs[name]
+	c.optionGroupMu.Unlock()
+	if ok {
+		return id, nil
+	}
+
 	where := [][]any{
 		{"name", "=", name},
 	}
 
-	results, err := c.Get("OptionGroup", where, []string{"id"})
+	results, err := c.Get(ctx, "OptionGroup", where, []string{"id"})
 	if err != nil {
 		return 0, fmt.Errorf("failed to look up option group '%s': %w", name, err)
 	}
 
 	if len(results) == 0 {
-		return 0, fmt.Errorf("option group '%s' not found", name)
+		return 0, fmt.Errorf("option group '%s' %w", name, ErrNotFound)
 	}
 
-	id, ok := GetInt64(results[0], "id")
+	id, ok = GetInt64(results[0], "id")
 	if !ok {
 		return 0, fmt.Errorf("option group '%s' has no valid id", name)
 	}
 
+	c.optionGroupMu.Lock()
+	if c.optionGroupIDs == nil {
+		c.optionGroupIDs = map[string]int64{}
+	}
+	c.optionGroupIDs[name] = id
+	c.optionGroupMu.Unlock()
+
 	return id, nil
 }
+
+// forgetOptionGroupIDs clears the cache of GetOptionGroupID. It is called for
+// every change of an option group, as a renamed or recreated group would
+// leave a stale ID behind.
+func (c *Client) forgetOptionGroupIDs() {
+	c.optionGroupMu.Lock()
+	c.optionGroupIDs = nil
+	c.optionGroupMu.Unlock()
+}
+
+// aclObjectNameFields maps an ACL object_table to the API entity and field that
+// hold the human-readable name of the permissioned object.
+var aclObjectNameFields = map[string][2]string{
+	"civicrm_group":        {"Group", "title"},
+	"civicrm_saved_search": {"SavedSearch", "label"},
+	"civicrm_uf_group":     {"UFGroup", "title"},
+	"civicrm_custom_group": {"CustomGroup", "title"},
+	"civicrm_event":        {"Event", "title"},
+}
+
+// GetACLObjectName resolves an ACL object_id against its object_table to the
+// name of the permissioned object. It returns false for unsupported tables
+// and for objects that no longer exist, which ACL rules can still point to.
+func (c *Client) GetACLObjectName(ctx context.Context, objectTable string, objectID int64) (string, bool, error) {
+	entity, ok := aclObjectNameFields[objectTable]
+	if !ok {
+		return "", false, nil
+	}
+
+	result, err := c.GetByID(ctx, entity[0], objectID, []string{"id", entity[1]})
+	if errors.Is(err, ErrNotFound) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("failed to look up %s %d: %w", entity[0], objectID, err)
+	}
+
+	name, ok := GetString(result, entity[1])
+	return name, ok, nil
+}
+
+// GetACLObjectNames resolves several object_ids of one ACL object_table to
+// the names of the permissioned objects in one request. Objects that no
+// longer exist are left out of the result, which is nil for unsupported
+// tables.
+func (c *Client) GetACLObjectNames(ctx context.Context, objectTable string, objectIDs []int64) (map[int64]string, error) {
+	entity, ok := aclObjectNameFields[objectTable]
+	if !ok {
+		return nil, nil
+	}
+
+	names := make(map[int64]string, len(objectIDs))
+	if len(objectIDs) == 0 {
+		return names, nil
+	}
+
+	results, err := c.Get(ctx, entity[0], [][]any{
+		{"id", "IN", objectIDs},
+	}, []string{"id", entity[1]})
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up %s %v: %w", entity[0], objectIDs, err)
+	}
+
+	for _, result := range results {
+		id, ok := GetInt64(result, "id")
+		if !ok {
+			continue
+		}
+		names[id], _ = GetString(result, entity[1])
+	}
+	return names, nil
+}
+
+// legacyResponse represents a CiviCRM API v3 REST response
+type legacyResponse struct {
+	IsError      int          `json:"is_error"`
+	ErrorMessage string       `json:"error_message,omitempty"`
+	Values       legacyValues `json:"values"`
+}
+
+// legacyValues are the values of an API v3 response. Most actions return a
+// list, but delete returns true or 1, which is decoded as no values.
+type legacyValues []map[string]any
+
+func (v *legacyValues) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] != '[' {
+		*v = nil
+		return nil
+	}
+	return json.Unmarshal(data, (*[]map[string]any)(v))
+}
+
+// doLegacyRequest calls the CiviCRM API v3 REST endpoint. API v4 has no
+// Attachment entity and no actions to manage extensions, so file uploads and
+// extension changes go through API v3. The parameters are sent as
+// multipart/form-data fields so that binary content is passed through as is.
+func (c *Client) doLegacyRequest(ctx context.Context, entity, action string, params map[string]string) ([]map[string]any, error) {
+	ctx = tflog.SetField(ctx, "civicrm_entity", entity)
+	ctx = tflog.SetField(ctx, "civicrm_action", action)
+	ctx = tflog.SetField(ctx, "civicrm_api_version", 3)
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	fields := map[string]string{
+		"entity":     entity,
+		"action":     action,
+		"json":       "1",
+		"sequential": "1",
+	}
+	for k, v := range params {
+		fields[k] = v
+	}
+
+	for k, v := range fields {
+		if err := writer.WriteField(k, v); err != nil {
+			return nil, fmt.Errorf("failed to write field '%s': %w", k, err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to finish multipart body: %w", err)
+	}
+
+	// Execute request
+	statusCode, respBody, err := c.send(ctx, action, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint("civicrm/ajax/rest"), bytes.NewReader(body.Bytes()))
+		if err != nil {
+			return nil, err
+		}
+
+		// Set headers
+		if err := c.setHeaders(req); err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		req.Header.Set("Accept", "application/json")
+		return req, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode < 200 || statusCode >= 300 {
+		return nil, newRawAPIError(entity, action, statusCode, string(respBody))
+	}
+
+	var apiResp legacyResponse
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w, body: %s", err, string(respBody))
+	}
+
+	if apiResp.IsError != 0 {
+		return nil, buildAPIError(entity, action, statusCode, 0, apiResp.ErrorMessage)
+	}
+
+	return apiResp.Values, nil
+}
+
+// CreateAttachment uploads content as a file named name and attaches it to the
+// given entity. The MIME type is derived from the file name, falling back to
+// sniffing the content.
+func (c *Client) CreateAttachment(ctx context.Context, entityTable string, entityID int64, name string, content []byte) (map[string]any, error) {
+	mimeType := mime.TypeByExtension(filepath.Ext(name))
+	if mimeType == "" {
+		mimeType = http.DetectContentType(content)
+	}
+
+	values, err := c.doLegacyRequest(ctx, "Attachment", "create", map[string]string{
+		"entity_table": entityTable,
+		"entity_id":    strconv.FormatInt(entityID, 10),
+		"name":         name,
+		"mime_type":    mimeType,
+		"content":      string(content),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no values returned from attachment create")
+	}
+
+	return values[0], nil
+}
+
+// GetAttachment retrieves an attachment by its file ID
+func (c *Client) GetAttachment(ctx context.Context, id int64) (map[string]any, error) {
+	values, err := c.doLegacyRequest(ctx, "Attachment", "get", map[string]string{
+		"id": strconv.FormatInt(id, 10),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(values) == 0 {
+		return nil, fmt.Errorf("attachment with ID %d %w", id, ErrNotFound)
+	}
+
+	return values[0], nil
+}
+
+// DeleteAttachment removes an attachment and its file by file ID
+func (c *Client) DeleteAttachment(ctx context.Context, id int64) error {
+	_, err := c.doLegacyRequest(ctx, "Attachment", "delete", map[string]string{
+		"id": strconv.FormatInt(id, 10),
+	})
+	return err
+}
+
+// GetExtension returns the extension with the given key. Extensions the server
+// does not know about have the status "unknown"
+func (c *Client) GetExtension(ctx context.Context, key string) (map[string]any, error) {
+	values, err := c.doLegacyRequest(ctx, "Extension", "get", map[string]string{
+		"key": key,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(values) == 0 {
+		return map[string]any{"key": key, "status": "unknown"}, nil
+	}
+
+	return values[0], nil
+}
+
+// GetExtensions returns all extensions the server knows about
+func (c *Client) GetExtensions(ctx context.Context) ([]map[string]any, error) {
+	return c.doLegacyRequest(ctx, "Extension", "get", map[string]string{
+		"options[limit]": "0",
+	})
+}
+
+// ChangeExtension runs an Extension action ("install", "enable", "disable" or
+// "uninstall") on the extension with the given key
+func (c *Client) ChangeExtension(ctx context.Context, action, key string) error {
+	_, err := c.doLegacyRequest(ctx, "Extension", action, map[string]string{
+		"keys": key,
+	})
+	return err
+}
+
+// DownloadExtension downloads the extension with the given key, replacing the
+// code of an existing copy. Without url, the latest release compatible with
+// the server is downloaded from the CiviCRM extension directory
+func (c *Client) DownloadExtension(ctx context.Context, key, url string) error {
+	params := map[string]string{
+		"key":     key,
+		"install": "0",
+	}
+	if url != "" {
+		params["url"] = url
+	}
+
+	_, err := c.doLegacyRequest(ctx, "Extension", "download", params)
+	return err
+}
+
+// UpgradeExtensions runs the pending database upgrades of all extensions
+func (c *Client) UpgradeExtensions(ctx context.Context) error {
+	_, err := c.doLegacyRequest(ctx, "Extension", "upgrade", map[string]string{})
+	return err
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientCreateAttachment(t *testing.T) {
+	content := []byte("%PDF-1.4\x00\xff binary content")
+	fields := map[string]string{}
+	var fileContent []byte
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		if r.URL.Path != "/civicrm/ajax/rest" {
+			t.Errorf("path = %s, want /civicrm/ajax/rest", r.URL.Path)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer secret" {
+			t.Errorf("Authorization = %q, want %q", got, "Bearer secret")
+		}
+
+		reader, err := r.MultipartReader()
+		if err != nil {
+			t.Errorf("request is not multipart/form-data: %v", err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Errorf("failed to read part: %v", err)
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			value, err := io.ReadAll(part)
+			if err != nil {
+				t.Errorf("failed to read part %s: %v", part.FormName(), err)
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if part.FormName() == "content" {
+				fileContent = value
+				continue
+			}
+			fields[part.FormName()] = string(value)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"is_error":0,"values":[{"id":"42","name":"report.pdf","mime_type":"application/pdf","url":"https://example.org/file/42"}]}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, "secret", "", false)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	result, err := client.CreateAttachment(context.Background(), "civicrm_activity", 7, "report.pdf", content)
+	if err != nil {
+		t.Fatalf("CreateAttachment: %v", err)
+	}
+
+	want := map[string]string{
+		"entity":       "Attachment",
+		"action":       "create",
+		"json":         "1",
+		"sequential":   "1",
+		"entity_table": "civicrm_activity",
+		"entity_id":    "7",
+		"name":         "report.pdf",
+		"mime_type":    "application/pdf",
+	}
+	for name, value := range want {
+		if fields[name] != value {
+			t.Errorf("field %s = %q, want %q", name, fields[name], value)
+		}
+	}
+	if !bytes.Equal(fileContent, content) {
+		t.Errorf("content = %q, want %q", fileContent, content)
+	}
+
+	if id, ok := GetInt64(result, "id"); !ok || id != 42 {
+		t.Errorf("id = %v, want 42", result["id"])
+	}
+	if url, _ := GetString(result, "url"); url != "https://example.org/file/42" {
+		t.Errorf("url = %q, want https://example.org/file/42", url)
+	}
+}
+
+func TestNewClientAPIKeyHeader(t *testing.T) {
+	tests := []struct {
+		name         string
+		apiKeyHeader string
+		wantHeader   string
+		wantValue    string
+	}{
+		{name: "default", apiKeyHeader: "", wantHeader: "Authorization", wantValue: "Bearer secret"},
+		{name: "custom header", apiKeyHeader: "X-Civi-Key", wantHeader: "X-Civi-Key", wantValue: "secret"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var header http.Header
+			server := newTestServer(t, func(req testRequest) ([]map[string]any, error) {
+				header = req.Header
+				return nil, nil
+			})
+
+			client, err := NewClient(server.URL, "secret", tt.apiKeyHeader, false)
+			if err != nil {
+				t.Fatalf("NewClient: %v", err)
+			}
+
+			if _, err := client.Get(context.Background(), "Contact", nil, []string{"id"}); err != nil {
+				t.Fatalf("Get: %v", err)
+			}
+
+			if got := header.Get(tt.wantHeader); got != tt.wantValue {
+				t.Errorf("%s = %q, want %q", tt.wantHeader, got, tt.wantValue)
+			}
+			if tt.wantHeader != "Authorization" && header.Get("Authorization") != "" {
+				t.Errorf("Authorization = %q, want no Authorization header", header.Get("Authorization"))
+			}
+		})
+	}
+}
@@ -25,6 +25,7 @@ type ACLDataSourceModel struct {
 	Operation   types.String `tfsdk:"operation"`
 	ObjectTable types.String `tfsdk:"object_table"`
 	ObjectID    types.Int64  `tfsdk:"object_id"`
+	ObjectName  types.String `tfsdk:"object_name"`
 	IsActive    types.Bool   `tfsdk:"is_active"`
 	Deny        types.Bool   `tfsdk:"deny"`
 	Priority    types.Int64  `tfsdk:"priority"`
@@ -72,6 +73,10 @@ func (d *ACLDataSource) Schema(ctx context.Context, req datasource.SchemaRequest
 				Description: "The ID of the specific object being permissioned.",
 				Computed:    true,
 			},
+			"object_name": schema.StringAttribute{
+				Description: "The name of the permissioned object (e.g. the group title), resolved from object_table and object_id. Null when the ACL applies to all objects, the object no longer exists or the object type is not supported.",
+				Computed:    true,
+			},
 			"is_active": schema.BoolAttribute{
 				Description: "Whether the ACL rule is active.",
 				Computed:    true,
@@ -134,7 +139,7 @@ func (d *ACLDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		"filters": where,
 	})
 
-	results, err := d.client.Get("ACL", where, nil)
+	results, err := d.client.Get(ctx, "ACL", where, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading ACL",
@@ -184,6 +189,21 @@ func (d *ACLDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		config.ObjectID = types.Int64Null()
 	}
 
+	config.ObjectName = types.StringNull()
+	if !config.ObjectID.IsNull() && config.ObjectID.ValueInt64() != 0 {
+		objectName, ok, err := d.client.GetACLObjectName(ctx, config.ObjectTable.ValueString(), config.ObjectID.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading ACL",
+				"Could not resolve ACL object name: "+err.Error(),
+			)
+			return
+		}
+		if ok {
+			config.ObjectName = types.StringValue(objectName)
+		}
+	}
+
 	if active, ok := GetBool(result, "is_active"); ok {
 		config.IsActive = types.BoolValue(active)
 	}
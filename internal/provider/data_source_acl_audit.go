@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &ACLAuditDataSource{}
+var _ datasource.DataSourceWithConfigure = &ACLAuditDataSource{}
+
+// ACLAuditDataSource lists the ACL rules of several ACL roles at once. It is
+// the batched form of civicrm_acl_role_rules for audits of many roles.
+type ACLAuditDataSource struct {
+	client *Client
+}
+
+type ACLAuditDataSourceModel struct {
+	ACLRoleIDs types.List `tfsdk:"acl_role_ids"`
+	Rules      types.Map  `tfsdk:"rules"`
+}
+
+func NewACLAuditDataSource() datasource.DataSource {
+	return &ACLAuditDataSource{}
+}
+
+func (d *ACLAuditDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl_audit"
+}
+
+func (d *ACLAuditDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the CiviCRM ACL rules of several ACL roles at once.",
+		Attributes: map[string]schema.Attribute{
+			"acl_role_ids": schema.ListAttribute{
+				Description: "The ACL roles to audit, as stored in the entity_id of their ACL rules.",
+				Required:    true,
+				ElementType: types.Int64Type,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"rules": schema.MapAttribute{
+				Description: "The ACL rules of each requested role, keyed by ACL role ID and ordered by object table and ID. Roles without rules have an empty list.",
+				Computed:    true,
+				ElementType: types.ListType{ElemType: aclRoleRuleType},
+			},
+		},
+	}
+}
+
+func (d *ACLAuditDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ACLAuditDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ACLAuditDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var roleIDs []int64
+	resp.Diagnostics.Append(config.ACLRoleIDs.ElementsAs(ctx, &roleIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading ACL audit data source", map[string]any{
+		"acl_role_ids": roleIDs,
+	})
+
+	// One request for the rules of all roles, and one per object table for
+	// the names of the objects they apply to
+	results, err := d.client.GetAll(ctx, "ACL", [][]any{
+		{"entity_table", "=", "civicrm_acl_role"},
+		{"entity_id", "IN", roleIDs},
+	}, aclRoleRuleFields, []string{"entity_id", "object_table"})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ACL audit",
+			"Could not read ACL rules: "+err.Error(),
+		)
+		return
+	}
+
+	rules := make(map[string][]ACLRoleRuleModel, len(roleIDs))
+	for _, roleID := range roleIDs {
+		rules[strconv.FormatInt(roleID, 10)] = []ACLRoleRuleModel{}
+	}
+
+	objectNames, err := aclRuleObjectNames(ctx, d.client, results)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ACL audit",
+			"Could not resolve object names of ACL rules: "+err.Error(),
+		)
+		return
+	}
+
+	for _, result := range results {
+		roleID, _ := GetInt64(result, "entity_id")
+		key := strconv.FormatInt(roleID, 10)
+		rules[key] = append(rules[key], aclRoleRuleFromResult(result, objectNames))
+	}
+
+	rulesMap, diags := types.MapValueFrom(ctx, types.ListType{ElemType: aclRoleRuleType}, rules)
+	resp.Diagnostics.Append(diags...)
+	config.Rules = rulesMap
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
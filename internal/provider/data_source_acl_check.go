@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &ACLCheckDataSource{}
+var _ datasource.DataSourceWithConfigure = &ACLCheckDataSource{}
+
+// ACLCheckDataSource evaluates the ACL rules that apply to a contact. It
+// resolves the contact's groups to ACL roles and picks the deciding rule the
+// same way CiviCRM does: highest priority first, deny before allow.
+type ACLCheckDataSource struct {
+	client *Client
+}
+
+type ACLCheckDataSourceModel struct {
+	ContactID   types.Int64  `tfsdk:"contact_id"`
+	Operation   types.String `tfsdk:"operation"`
+	ObjectTable types.String `tfsdk:"object_table"`
+	ObjectID    types.Int64  `tfsdk:"object_id"`
+	Allowed     types.Bool   `tfsdk:"allowed"`
+	RuleID      types.Int64  `tfsdk:"rule_id"`
+}
+
+// aclImpliedOperations lists the ACL operations that grant a requested
+// operation. Edit access includes View access.
+var aclImpliedOperations = map[string][]string{
+	"View":   {"View", "Edit", "All"},
+	"Edit":   {"Edit", "All"},
+	"Create": {"Create", "All"},
+	"Delete": {"Delete", "All"},
+	"Search": {"Search", "All"},
+	"All":    {"All"},
+}
+
+func NewACLCheckDataSource() datasource.DataSource {
+	return &ACLCheckDataSource{}
+}
+
+func (d *ACLCheckDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl_check"
+}
+
+func (d *ACLCheckDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Checks whether a contact is granted an operation on an object by the current CiviCRM ACL rules.",
+		Attributes: map[string]schema.Attribute{
+			"contact_id": schema.Int64Attribute{
+				Description: "The ID of the contact to check.",
+				Required:    true,
+			},
+			"operation": schema.StringAttribute{
+				Description: "The operation to check. Options: 'View', 'Edit', 'Create', 'Delete', 'Search', 'All'.",
+				Required:    true,
+			},
+			"object_table": schema.StringAttribute{
+				Description: "The type of object to check (e.g., 'civicrm_group').",
+				Required:    true,
+			},
+			"object_id": schema.Int64Attribute{
+				Description: "The ID of the object to check. Leave empty to check access to all objects of the type.",
+				Optional:    true,
+			},
+			"allowed": schema.BoolAttribute{
+				Description: "Whether the contact is granted the operation.",
+				Computed:    true,
+			},
+			"rule_id": schema.Int64Attribute{
+				Description: "The ID of the ACL rule that decided the result. Null when no rule applies.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *ACLCheckDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ACLCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ACLCheckDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operations, ok := aclImpliedOperations[config.Operation.ValueString()]
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Invalid operation",
+			"Unsupported ACL operation: "+config.Operation.ValueString(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Checking ACL access", map[string]any{
+		"contact_id":   config.ContactID.ValueInt64(),
+		"operation":    config.Operation.ValueString(),
+		"object_table": config.ObjectTable.ValueString(),
+	})
+
+	roles, err := d.contactRoles(ctx, config.ContactID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error checking ACL access",
+			"Could not resolve ACL roles: "+err.Error(),
+		)
+		return
+	}
+
+	rules, err := d.client.Get(ctx, "ACL", [][]any{
+		{"entity_table", "=", "civicrm_acl_role"},
+		{"entity_id", "IN", roles},
+		{"operation", "IN", operations},
+		{"object_table", "=", config.ObjectTable.ValueString()},
+		{"is_active", "=", true},
+	}, []string{"id", "object_id", "deny", "priority"})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error checking ACL access",
+			"Could not read ACL rules: "+err.Error(),
+		)
+		return
+	}
+
+	config.Allowed = types.BoolValue(false)
+	config.RuleID = types.Int64Null()
+
+	var deciding map[string]any
+	for _, rule := range rules {
+		if !aclRuleMatchesObject(rule, config.ObjectID) {
+			continue
+		}
+		if deciding == nil || aclRuleTakesPrecedence(rule, deciding) {
+			deciding = rule
+		}
+	}
+
+	if deciding != nil {
+		deny, _ := GetBool(deciding, "deny")
+		config.Allowed = types.BoolValue(!deny)
+		if id, ok := GetInt64(deciding, "id"); ok {
+			config.RuleID = types.Int64Value(id)
+		}
+	}
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// contactRoles returns the ACL role values that apply to a contact through its
+// static group memberships, including role 0 which applies to everyone.
+func (d *ACLCheckDataSource) contactRoles(ctx context.Context, contactID int64) ([]int64, error) {
+	roles := []int64{0}
+
+	memberships, err := d.client.Get(ctx, "GroupContact", [][]any{
+		{"contact_id", "=", contactID},
+		{"status", "=", "Added"},
+	}, []string{"group_id"})
+	if err != nil {
+		return nil, err
+	}
+
+	groupIDs := make([]int64, 0, len(memberships))
+	for _, m := range memberships {
+		if groupID, ok := GetInt64(m, "group_id"); ok {
+			groupIDs = append(groupIDs, groupID)
+		}
+	}
+
+	if len(groupIDs) == 0 {
+		return roles, nil
+	}
+
+	entityRoles, err := d.client.Get(ctx, "ACLEntityRole", [][]any{
+		{"entity_table", "=", "civicrm_group"},
+		{"entity_id", "IN", groupIDs},
+		{"is_active", "=", true},
+	}, []string{"acl_role_id"})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, er := range entityRoles {
+		if roleID, ok := GetInt64(er, "acl_role_id"); ok {
+			roles = append(roles, roleID)
+		}
+	}
+
+	return roles, nil
+}
+
+// aclRuleMatchesObject reports whether an ACL rule covers the requested object.
+// Rules without an object_id (or with 0) cover all objects of their type.
+func aclRuleMatchesObject(rule map[string]any, objectID types.Int64) bool {
+	ruleObjectID, ok := GetInt64(rule, "object_id")
+	if !ok || ruleObjectID == 0 {
+		return true
+	}
+	return !objectID.IsNull() && ruleObjectID == objectID.ValueInt64()
+}
+
+// aclRuleTakesPrecedence reports whether rule decides over current: a higher
+// priority wins, and on equal priority a deny rule wins.
+func aclRuleTakesPrecedence(rule, current map[string]any) bool {
+	rulePriority, _ := GetInt64(rule, "priority")
+	currentPriority, _ := GetInt64(current, "priority")
+	if rulePriority != currentPriority {
+		return rulePriority > currentPriority
+	}
+
+	ruleDeny, _ := GetBool(rule, "deny")
+	currentDeny, _ := GetBool(current, "deny")
+	return ruleDeny && !currentDeny
+}
@@ -121,7 +121,7 @@ func (d *ACLEntityRoleDataSource) Read(ctx context.Context, req datasource.ReadR
 		"filters": where,
 	})
 
-	results, err := d.client.Get("ACLEntityRole", where, nil)
+	results, err := d.client.Get(ctx, "ACLEntityRole", where, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading ACL entity role",
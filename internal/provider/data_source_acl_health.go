@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &ACLHealthDataSource{}
+var _ datasource.DataSourceWithConfigure = &ACLHealthDataSource{}
+
+// ACLHealthDataSource cross-references ACL roles and ACL entity roles to find
+// roles that are not assigned to any group and assignments whose role no
+// longer exists.
+type ACLHealthDataSource struct {
+	client *Client
+}
+
+type ACLHealthDataSourceModel struct {
+	OrphanedRoles       []ACLHealthRoleModel       `tfsdk:"orphaned_roles"`
+	DanglingAssignments []ACLHealthAssignmentModel `tfsdk:"dangling_assignments"`
+}
+
+type ACLHealthRoleModel struct {
+	ID    types.Int64  `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Label types.String `tfsdk:"label"`
+	Value types.String `tfsdk:"value"`
+}
+
+type ACLHealthAssignmentModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	ACLRoleID   types.Int64  `tfsdk:"acl_role_id"`
+	EntityTable types.String `tfsdk:"entity_table"`
+	EntityID    types.Int64  `tfsdk:"entity_id"`
+}
+
+func NewACLHealthDataSource() datasource.DataSource {
+	return &ACLHealthDataSource{}
+}
+
+func (d *ACLHealthDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl_health"
+}
+
+func (d *ACLHealthDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reports ACL roles without an active assignment and ACL entity roles that reference a role that does not exist.",
+		Attributes: map[string]schema.Attribute{
+			"orphaned_roles": schema.ListNestedAttribute{
+				Description: "The active ACL roles that are not assigned to any group by an active ACL entity role, ordered by ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "The ID of the ACL role (OptionValue ID).",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The machine name of the ACL role.",
+							Computed:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "The display label of the ACL role.",
+							Computed:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "The value of the ACL role, which ACL entity roles reference as acl_role_id.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"dangling_assignments": schema.ListNestedAttribute{
+				Description: "The ACL entity roles whose acl_role_id does not match any ACL role, ordered by ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "The ID of the ACL entity role.",
+							Computed:    true,
+						},
+						"acl_role_id": schema.Int64Attribute{
+							Description: "The ACL role the assignment references.",
+							Computed:    true,
+						},
+						"entity_table": schema.StringAttribute{
+							Description: "The type of entity the role is assigned to.",
+							Computed:    true,
+						},
+						"entity_id": schema.Int64Attribute{
+							Description: "The ID of the entity the role is assigned to.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ACLHealthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ACLHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading ACL health data source")
+
+	roles, err := d.client.GetAll(ctx, "OptionValue", [][]any{
+		{"option_group_id:name", "=", "acl_role"},
+	}, []string{"id", "name", "label", "value", "is_active"}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ACL health",
+			"Could not read ACL roles: "+err.Error(),
+		)
+		return
+	}
+
+	assignments, err := d.client.GetAll(ctx, "ACLEntityRole", [][]any{},
+		[]string{"id", "acl_role_id", "entity_table", "entity_id", "is_active"}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ACL health",
+			"Could not read ACL entity roles: "+err.Error(),
+		)
+		return
+	}
+
+	// ACL entity roles reference the role's value, not its OptionValue ID
+	roleValues := make(map[int64]bool, len(roles))
+	for _, role := range roles {
+		value, _ := GetString(role, "value")
+		if v, err := strconv.ParseInt(value, 10, 64); err == nil {
+			roleValues[v] = true
+		}
+	}
+
+	assigned := make(map[int64]bool, len(assignments))
+	state := ACLHealthDataSourceModel{
+		OrphanedRoles:       []ACLHealthRoleModel{},
+		DanglingAssignments: []ACLHealthAssignmentModel{},
+	}
+
+	for _, assignment := range assignments {
+		roleID, _ := GetInt64(assignment, "acl_role_id")
+		if !roleValues[roleID] {
+			dangling := ACLHealthAssignmentModel{
+				ID:          types.Int64Null(),
+				ACLRoleID:   types.Int64Value(roleID),
+				EntityTable: types.StringNull(),
+				EntityID:    types.Int64Null(),
+			}
+			if id, ok := GetInt64(assignment, "id"); ok {
+				dangling.ID = types.Int64Value(id)
+			}
+			if entityTable, ok := GetString(assignment, "entity_table"); ok {
+				dangling.EntityTable = types.StringValue(entityTable)
+			}
+			if entityID, ok := GetInt64(assignment, "entity_id"); ok {
+				dangling.EntityID = types.Int64Value(entityID)
+			}
+			state.DanglingAssignments = append(state.DanglingAssignments, dangling)
+			continue
+		}
+
+		if isActive, _ := GetBool(assignment, "is_active"); isActive {
+			assigned[roleID] = true
+		}
+	}
+
+	for _, role := range roles {
+		if isActive, _ := GetBool(role, "is_active"); !isActive {
+			continue
+		}
+
+		value, _ := GetString(role, "value")
+		if v, err := strconv.ParseInt(value, 10, 64); err == nil && assigned[v] {
+			continue
+		}
+
+		orphaned := ACLHealthRoleModel{
+			ID:    types.Int64Null(),
+			Name:  types.StringNull(),
+			Label: types.StringNull(),
+			Value: types.StringValue(value),
+		}
+		if id, ok := GetInt64(role, "id"); ok {
+			orphaned.ID = types.Int64Value(id)
+		}
+		if name, ok := GetString(role, "name"); ok {
+			orphaned.Name = types.StringValue(name)
+		}
+		if label, ok := GetString(role, "label"); ok {
+			orphaned.Label = types.StringValue(label)
+		}
+		state.OrphanedRoles = append(state.OrphanedRoles, orphaned)
+	}
+
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -122,7 +122,7 @@ func (d *ACLRoleDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		"filters": where,
 	})
 
-	results, err := d.client.Get("OptionValue", where, nil)
+	results, err := d.client.Get(ctx, "OptionValue", where, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading ACL role",
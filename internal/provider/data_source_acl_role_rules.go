@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &ACLRoleRulesDataSource{}
+var _ datasource.DataSourceWithConfigure = &ACLRoleRulesDataSource{}
+
+// ACLRoleRulesDataSource lists all ACL rules granted to an ACL role, for
+// reviewing what a role can do.
+type ACLRoleRulesDataSource struct {
+	client *Client
+}
+
+type ACLRoleRulesDataSourceModel struct {
+	ACLRoleID types.Int64        `tfsdk:"acl_role_id"`
+	Rules     []ACLRoleRuleModel `tfsdk:"rules"`
+}
+
+type ACLRoleRuleModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	Operation     types.String `tfsdk:"operation"`
+	ObjectTable   types.String `tfsdk:"object_table"`
+	ObjectName    types.String `tfsdk:"object_name"`
+	ObjectID      types.Int64  `tfsdk:"object_id"`
+	ObjectMissing types.Bool   `tfsdk:"object_missing"`
+	Deny          types.Bool   `tfsdk:"deny"`
+	Priority      types.Int64  `tfsdk:"priority"`
+}
+
+func NewACLRoleRulesDataSource() datasource.DataSource {
+	return &ACLRoleRulesDataSource{}
+}
+
+func (d *ACLRoleRulesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl_role_rules"
+}
+
+func (d *ACLRoleRulesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all CiviCRM ACL rules that belong to an ACL role.",
+		Attributes: map[string]schema.Attribute{
+			"acl_role_id": schema.Int64Attribute{
+				Description: "The ACL role, as stored in the entity_id of its ACL rules.",
+				Required:    true,
+			},
+			"rules": schema.ListNestedAttribute{
+				Description: "The ACL rules of the role, ordered by object table and ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "The ID of the ACL rule.",
+							Computed:    true,
+						},
+						"operation": schema.StringAttribute{
+							Description: "The operation the rule grants or denies.",
+							Computed:    true,
+						},
+						"object_table": schema.StringAttribute{
+							Description: "The type of object the rule applies to.",
+							Computed:    true,
+						},
+						"object_name": schema.StringAttribute{
+							Description: "The name of the object the rule applies to. Null for rules covering all objects of the type, for unsupported object tables and for objects that no longer exist.",
+							Computed:    true,
+						},
+						"object_id": schema.Int64Attribute{
+							Description: "The ID of the object the rule applies to. Null for all objects of the type.",
+							Computed:    true,
+						},
+						"object_missing": schema.BoolAttribute{
+							Description: "Whether the object the rule applies to no longer exists. Such rules are left over from deleted groups, saved searches or profiles.",
+							Computed:    true,
+						},
+						"deny": schema.BoolAttribute{
+							Description: "Whether the rule denies rather than grants the operation.",
+							Computed:    true,
+						},
+						"priority": schema.Int64Attribute{
+							Description: "The priority of the rule.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ACLRoleRulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ACLRoleRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ACLRoleRulesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading ACL role rules data source", map[string]any{
+		"acl_role_id": config.ACLRoleID.ValueInt64(),
+	})
+
+	results, err := d.client.GetAll(ctx, "ACL", [][]any{
+		{"entity_table", "=", "civicrm_acl_role"},
+		{"entity_id", "=", config.ACLRoleID.ValueInt64()},
+	}, aclRoleRuleFields, []string{"object_table"})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ACL role rules",
+			"Could not read ACL rules of ACL role "+strconv.FormatInt(config.ACLRoleID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	objectNames, err := aclRuleObjectNames(ctx, d.client, results)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ACL role rules",
+			"Could not resolve object names of ACL rules: "+err.Error(),
+		)
+		return
+	}
+
+	config.Rules = make([]ACLRoleRuleModel, 0, len(results))
+	for _, result := range results {
+		config.Rules = append(config.Rules, aclRoleRuleFromResult(result, objectNames))
+	}
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// aclRoleRuleFields are the ACL fields read into an ACLRoleRuleModel.
+var aclRoleRuleFields = []string{"id", "entity_id", "operation", "object_table", "object_id", "deny", "priority"}
+
+// aclRoleRuleType is the object type of an ACLRoleRuleModel.
+var aclRoleRuleType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":             types.Int64Type,
+		"operation":      types.StringType,
+		"object_table":   types.StringType,
+		"object_name":    types.StringType,
+		"object_id":      types.Int64Type,
+		"object_missing": types.BoolType,
+		"deny":           types.BoolType,
+		"priority":       types.Int64Type,
+	},
+}
+
+// aclRuleObjectNames resolves the names of the objects that ACL rules apply
+// to, with one request per object table rather than one per rule. The names
+// are keyed by object table and ID; unsupported tables are left out.
+func aclRuleObjectNames(ctx context.Context, client *Client, results []map[string]any) (map[string]map[int64]string, error) {
+	objectIDs := map[string][]int64{}
+	for _, result := range results {
+		objectTable, _ := GetString(result, "object_table")
+		if _, ok := aclObjectNameFields[objectTable]; !ok {
+			continue
+		}
+		if objectID, ok := GetInt64(result, "object_id"); ok && objectID != 0 && !slices.Contains(objectIDs[objectTable], objectID) {
+			objectIDs[objectTable] = append(objectIDs[objectTable], objectID)
+		}
+	}
+
+	objectNames := make(map[string]map[int64]string, len(objectIDs))
+	for objectTable, ids := range objectIDs {
+		names, err := client.GetACLObjectNames(ctx, objectTable, ids)
+		if err != nil {
+			return nil, err
+		}
+		objectNames[objectTable] = names
+	}
+	return objectNames, nil
+}
+
+// aclRoleRuleFromResult maps an ACL rule returned by the API, taking the name
+// of the object it applies to from objectNames, see aclRuleObjectNames. A
+// rule whose object no longer exists is flagged rather than failing the whole
+// list.
+func aclRoleRuleFromResult(result map[string]any, objectNames map[string]map[int64]string) ACLRoleRuleModel {
+	rule := ACLRoleRuleModel{
+		ID:            types.Int64Null(),
+		Operation:     types.StringNull(),
+		ObjectTable:   types.StringNull(),
+		ObjectName:    types.StringNull(),
+		ObjectID:      types.Int64Null(),
+		ObjectMissing: types.BoolValue(false),
+		Deny:          types.BoolValue(false),
+		Priority:      types.Int64Null(),
+	}
+
+	if id, ok := GetInt64(result, "id"); ok {
+		rule.ID = types.Int64Value(id)
+	}
+
+	if operation, ok := GetString(result, "operation"); ok {
+		rule.Operation = types.StringValue(operation)
+	}
+
+	if objectTable, ok := GetString(result, "object_table"); ok {
+		rule.ObjectTable = types.StringValue(objectTable)
+	}
+
+	if objectID, ok := GetInt64(result, "object_id"); ok && objectID != 0 {
+		rule.ObjectID = types.Int64Value(objectID)
+
+		if names, ok := objectNames[rule.ObjectTable.ValueString()]; ok {
+			if name, found := names[objectID]; found {
+				rule.ObjectName = types.StringValue(name)
+			} else {
+				rule.ObjectMissing = types.BoolValue(true)
+			}
+		}
+	}
+
+	if deny, ok := GetBool(result, "deny"); ok {
+		rule.Deny = types.BoolValue(deny)
+	}
+
+	if priority, ok := GetInt64(result, "priority"); ok {
+		rule.Priority = types.Int64Value(priority)
+	}
+
+	return rule
+}
@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &ACLRolesDataSource{}
+var _ datasource.DataSourceWithConfigure = &ACLRolesDataSource{}
+
+// ACLRolesDataSource lists all ACL roles. It is the plural form of
+// civicrm_acl_role for audits and for_each over every role.
+type ACLRolesDataSource struct {
+	client *Client
+}
+
+type ACLRolesDataSourceModel struct {
+	IsActive types.Bool          `tfsdk:"is_active"`
+	Limit    types.Int64         `tfsdk:"limit"`
+	Roles    []ACLRolesItemModel `tfsdk:"roles"`
+}
+
+type ACLRolesItemModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Label       types.String `tfsdk:"label"`
+	Description types.String `tfsdk:"description"`
+	IsActive    types.Bool   `tfsdk:"is_active"`
+	Weight      types.Int64  `tfsdk:"weight"`
+	Value       types.String `tfsdk:"value"`
+}
+
+func NewACLRolesDataSource() datasource.DataSource {
+	return &ACLRolesDataSource{}
+}
+
+func (d *ACLRolesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl_roles"
+}
+
+func (d *ACLRolesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all CiviCRM ACL Roles.",
+		Attributes: map[string]schema.Attribute{
+			"is_active": schema.BoolAttribute{
+				Description: "Only list active (true) or inactive (false) ACL roles.",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of ACL roles to list. Default: all of them.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"roles": schema.ListNestedAttribute{
+				Description: "The ACL roles, ordered by weight.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "The unique identifier of the ACL role (OptionValue ID).",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The machine name of the ACL role.",
+							Computed:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "The display label of the ACL role.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "A description of the ACL role.",
+							Computed:    true,
+						},
+						"is_active": schema.BoolAttribute{
+							Description: "Whether the ACL role is active.",
+							Computed:    true,
+						},
+						"weight": schema.Int64Attribute{
+							Description: "The sort weight of the ACL role.",
+							Computed:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "The value of the ACL role (used internally by CiviCRM).",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ACLRolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ACLRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ACLRolesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// ACL Roles are stored as OptionValues in the acl_role option group
+	where := [][]any{
+		{"option_group_id:name", "=", "acl_role"},
+	}
+	if !config.IsActive.IsNull() {
+		where = append(where, []any{"is_active", "=", config.IsActive.ValueBool()})
+	}
+
+	tflog.Debug(ctx, "Reading ACL roles data source", map[string]any{
+		"filters": where,
+	})
+
+	results, err := d.client.GetLimited(ctx, "OptionValue", where,
+		[]string{"id", "name", "label", "description", "is_active", "weight", "value"}, []string{"weight"}, int(config.Limit.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ACL roles",
+			"Could not read ACL roles: "+err.Error(),
+		)
+		return
+	}
+
+	config.Roles = make([]ACLRolesItemModel, 0, len(results))
+	for _, result := range results {
+		role := ACLRolesItemModel{
+			ID:          types.Int64Null(),
+			Name:        types.StringNull(),
+			Label:       types.StringNull(),
+			Description: types.StringNull(),
+			IsActive:    types.BoolNull(),
+			Weight:      types.Int64Null(),
+			Value:       types.StringNull(),
+		}
+
+		if id, ok := GetInt64(result, "id"); ok {
+			role.ID = types.Int64Value(id)
+		}
+
+		if name, ok := GetString(result, "name"); ok {
+			role.Name = types.StringValue(name)
+		}
+
+		if label, ok := GetString(result, "label"); ok {
+			role.Label = types.StringValue(label)
+		}
+
+		if desc, ok := GetString(result, "description"); ok && desc != "" {
+			role.Description = types.StringValue(desc)
+		}
+
+		if active, ok := GetBool(result, "is_active"); ok {
+			role.IsActive = types.BoolValue(active)
+		}
+
+		if weight, ok := GetInt64(result, "weight"); ok {
+			role.Weight = types.Int64Value(weight)
+		}
+
+		if value, ok := GetString(result, "value"); ok {
+			role.Value = types.StringValue(value)
+		}
+
+		config.Roles = append(config.Roles, role)
+	}
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
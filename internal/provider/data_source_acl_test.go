@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestACLDataSourceObjectName(t *testing.T) {
+	tests := []struct {
+		name           string
+		acl            map[string]any
+		groups         []map[string]any
+		wantObjectName types.String
+		wantLookup     bool
+	}{
+		{
+			name: "group",
+			acl: map[string]any{
+				"id": 7, "name": "Edit Volunteers", "entity_table": "civicrm_acl_role", "entity_id": 3,
+				"operation": "Edit", "object_table": "civicrm_group", "object_id": 42,
+				"is_active": true, "deny": false, "priority": 0,
+			},
+			groups:         []map[string]any{{"id": 42, "title": "Volunteers"}},
+			wantObjectName: types.StringValue("Volunteers"),
+			wantLookup:     true,
+		},
+		{
+			name: "deleted group",
+			acl: map[string]any{
+				"id": 8, "name": "Edit Old Group", "entity_table": "civicrm_acl_role", "entity_id": 3,
+				"operation": "Edit", "object_table": "civicrm_group", "object_id": 99,
+				"is_active": true, "deny": false, "priority": 0,
+			},
+			wantObjectName: types.StringNull(),
+			wantLookup:     true,
+		},
+		{
+			name: "all groups",
+			acl: map[string]any{
+				"id": 9, "name": "View All", "entity_table": "civicrm_acl_role", "entity_id": 3,
+				"operation": "View", "object_table": "civicrm_group", "object_id": nil,
+				"is_active": true, "deny": false, "priority": 0,
+			},
+			wantObjectName: types.StringNull(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var lookedUp bool
+			client := newTestClient(t, func(req testRequest) ([]map[string]any, error) {
+				switch req.Entity + "." + req.Action {
+				case "ACL.get":
+					return []map[string]any{tt.acl}, nil
+				case "Group.get":
+					lookedUp = true
+					return tt.groups, nil
+				}
+				t.Errorf("unexpected request %s.%s", req.Entity, req.Action)
+				return nil, errors.New("unexpected request")
+			})
+
+			d := &ACLDataSource{}
+			s := newTestDataSource(t, d, client)
+
+			config := ACLDataSourceModel{
+				ID:          types.Int64Value(int64(tt.acl["id"].(int))),
+				Name:        types.StringNull(),
+				EntityTable: types.StringNull(),
+				EntityID:    types.Int64Null(),
+				Operation:   types.StringNull(),
+				ObjectTable: types.StringNull(),
+				ObjectID:    types.Int64Null(),
+				ObjectName:  types.StringNull(),
+				IsActive:    types.BoolNull(),
+				Deny:        types.BoolNull(),
+				Priority:    types.Int64Null(),
+			}
+
+			resp := &datasource.ReadResponse{State: emptyTestDataSourceState(s)}
+			d.Read(ctx, datasource.ReadRequest{Config: testDataSourceConfig(t, s, config)}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read: %v", resp.Diagnostics)
+			}
+
+			var state ACLDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("failed to read state: %v", resp.Diagnostics)
+			}
+			if !state.ObjectName.Equal(tt.wantObjectName) {
+				t.Errorf("object_name = %s, want %s", state.ObjectName, tt.wantObjectName)
+			}
+			if lookedUp != tt.wantLookup {
+				t.Errorf("looked up the group = %t, want %t", lookedUp, tt.wantLookup)
+			}
+		})
+	}
+}
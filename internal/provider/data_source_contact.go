@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &ContactDataSource{}
+var _ datasource.DataSourceWithConfigure = &ContactDataSource{}
+
+// ContactDataSource looks up a single contact by external identifier, email
+// address or display name.
+type ContactDataSource struct {
+	client *Client
+}
+
+type ContactDataSourceModel struct {
+	ExternalIdentifier types.String `tfsdk:"external_identifier"`
+	Email              types.String `tfsdk:"email"`
+	DisplayName        types.String `tfsdk:"display_name"`
+	ID                 types.Int64  `tfsdk:"id"`
+	ContactType        types.String `tfsdk:"contact_type"`
+	ContactSubType     types.List   `tfsdk:"contact_sub_type"`
+}
+
+// contactDataSourceSelect selects the fields exported by the data source.
+var contactDataSourceSelect = []string{"id", "external_identifier", "display_name", "contact_type", "contact_sub_type"}
+
+func NewContactDataSource() datasource.DataSource {
+	return &ContactDataSource{}
+}
+
+func (d *ContactDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_contact"
+}
+
+func (d *ContactDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a CiviCRM contact by external identifier, email address or display name. The lookup must match exactly one contact that is not in the trash.",
+		Attributes: map[string]schema.Attribute{
+			"external_identifier": schema.StringAttribute{
+				Description: "The external identifier of the contact. Specify exactly one of external_identifier, email or display_name.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("email"), path.MatchRoot("display_name")),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "An email address of the contact. Specify exactly one of external_identifier, email or display_name.",
+				Optional:    true,
+			},
+			"display_name": schema.StringAttribute{
+				Description: "The display name of the contact. Specify exactly one of external_identifier, email or display_name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier of the contact.",
+				Computed:    true,
+			},
+			"contact_type": schema.StringAttribute{
+				Description: "The type of the contact: 'Individual', 'Organization' or 'Household'.",
+				Computed:    true,
+			},
+			"contact_sub_type": schema.ListAttribute{
+				Description: "The names of the subtypes of the contact.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *ContactDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ContactDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ContactDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build where clause based on provided filters
+	where := [][]any{
+		{"is_deleted", "=", false},
+	}
+	switch {
+	case !config.ExternalIdentifier.IsNull():
+		where = append(where, []any{"external_identifier", "=", config.ExternalIdentifier.ValueString()})
+	case !config.Email.IsNull():
+		contactIDs, err := d.contactIDsByEmail(ctx, config.Email.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading contact",
+				"Could not look up email address: "+err.Error(),
+			)
+			return
+		}
+		if len(contactIDs) == 0 {
+			resp.Diagnostics.AddError(
+				"Contact not found",
+				"No contact has the email address '"+config.Email.ValueString()+"'.",
+			)
+			return
+		}
+		where = append(where, []any{"id", "IN", contactIDs})
+	default:
+		where = append(where, []any{"display_name", "=", config.DisplayName.ValueString()})
+	}
+
+	tflog.Debug(ctx, "Reading contact data source", map[string]any{
+		"filters": where,
+	})
+
+	results, err := d.client.Get(ctx, "Contact", where, contactDataSourceSelect)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading contact",
+			"Could not read contact: "+err.Error(),
+		)
+		return
+	}
+
+	if len(results) == 0 {
+		resp.Diagnostics.AddError(
+			"Contact not found",
+			"No contact found matching the specified criteria.",
+		)
+		return
+	}
+
+	if len(results) > 1 {
+		resp.Diagnostics.AddError(
+			"Multiple contacts found",
+			fmt.Sprintf("%d contacts match the specified criteria. Use external_identifier to select a single contact.", len(results)),
+		)
+		return
+	}
+
+	result := results[0]
+
+	// Update state
+	if id, ok := GetInt64(result, "id"); ok {
+		config.ID = types.Int64Value(id)
+	}
+
+	if externalIdentifier, ok := GetString(result, "external_identifier"); ok && externalIdentifier != "" {
+		config.ExternalIdentifier = types.StringValue(externalIdentifier)
+	} else {
+		config.ExternalIdentifier = types.StringNull()
+	}
+
+	if displayName, ok := GetString(result, "display_name"); ok {
+		config.DisplayName = types.StringValue(displayName)
+	}
+
+	if contactType, ok := GetString(result, "contact_type"); ok {
+		config.ContactType = types.StringValue(contactType)
+	}
+
+	subTypes := []string{}
+	if subTypeSlice, ok := result["contact_sub_type"].([]any); ok {
+		for _, v := range subTypeSlice {
+			if s, ok := v.(string); ok {
+				subTypes = append(subTypes, s)
+			}
+		}
+	}
+	subTypeList, diags := types.ListValueFrom(ctx, types.StringType, subTypes)
+	resp.Diagnostics.Append(diags...)
+	config.ContactSubType = subTypeList
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// contactIDsByEmail returns the IDs of the contacts that have the given email
+// address, primary or not.
+func (d *ContactDataSource) contactIDsByEmail(ctx context.Context, email string) ([]int64, error) {
+	results, err := d.client.Get(ctx, "Email", [][]any{
+		{"email", "=", email},
+	}, []string{"contact_id"})
+	if err != nil {
+		return nil, err
+	}
+
+	contactIDs := []int64{}
+	for _, result := range results {
+		if contactID, ok := GetInt64(result, "contact_id"); ok {
+			contactIDs = append(contactIDs, contactID)
+		}
+	}
+
+	return contactIDs, nil
+}
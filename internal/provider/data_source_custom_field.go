@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &CustomFieldDataSource{}
+var _ datasource.DataSourceWithConfigure = &CustomFieldDataSource{}
+
+// CustomFieldDataSource looks up a custom field by its custom group and name.
+type CustomFieldDataSource struct {
+	client *Client
+}
+
+type CustomFieldDataSourceModel struct {
+	CustomGroupID   types.Int64  `tfsdk:"custom_group_id"`
+	CustomGroupName types.String `tfsdk:"custom_group_name"`
+	Name            types.String `tfsdk:"name"`
+	ID              types.Int64  `tfsdk:"id"`
+	Label           types.String `tfsdk:"label"`
+	ColumnName      types.String `tfsdk:"column_name"`
+	OptionGroupID   types.Int64  `tfsdk:"option_group_id"`
+	DataType        types.String `tfsdk:"data_type"`
+	HtmlType        types.String `tfsdk:"html_type"`
+	IsActive        types.Bool   `tfsdk:"is_active"`
+}
+
+// customFieldDataSourceSelect selects the fields of the custom field and the
+// name of its custom group.
+var customFieldDataSourceSelect = []string{"id", "custom_group_id", "custom_group_id:name", "name", "label", "column_name", "option_group_id", "data_type", "html_type", "is_active"}
+
+func NewCustomFieldDataSource() datasource.DataSource {
+	return &CustomFieldDataSource{}
+}
+
+func (d *CustomFieldDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_field"
+}
+
+func (d *CustomFieldDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a CiviCRM custom field by its custom group and name.",
+		Attributes: map[string]schema.Attribute{
+			"custom_group_id": schema.Int64Attribute{
+				Description: "The ID of the custom group of the field. Specify either custom_group_id or custom_group_name.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("custom_group_name")),
+				},
+			},
+			"custom_group_name": schema.StringAttribute{
+				Description: "The machine name of the custom group of the field. Specify either custom_group_id or custom_group_name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The machine name of the custom field.",
+				Required:    true,
+			},
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier of the custom field.",
+				Computed:    true,
+			},
+			"label": schema.StringAttribute{
+				Description: "The display label of the custom field.",
+				Computed:    true,
+			},
+			"column_name": schema.StringAttribute{
+				Description: "The database column that stores the values of the custom field.",
+				Computed:    true,
+			},
+			"option_group_id": schema.Int64Attribute{
+				Description: "The ID of the option group holding the options of the custom field, if it has options.",
+				Computed:    true,
+			},
+			"data_type": schema.StringAttribute{
+				Description: "The data type of the custom field (e.g., 'String', 'Int', 'Date').",
+				Computed:    true,
+			},
+			"html_type": schema.StringAttribute{
+				Description: "The input type of the custom field (e.g., 'Text', 'Select', 'Radio').",
+				Computed:    true,
+			},
+			"is_active": schema.BoolAttribute{
+				Description: "Whether the custom field is active.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *CustomFieldDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CustomFieldDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config CustomFieldDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build where clause based on provided filters
+	where := [][]any{
+		{"name", "=", config.Name.ValueString()},
+	}
+	if !config.CustomGroupID.IsNull() {
+		where = append(where, []any{"custom_group_id", "=", config.CustomGroupID.ValueInt64()})
+	} else {
+		where = append(where, []any{"custom_group_id:name", "=", config.CustomGroupName.ValueString()})
+	}
+
+	tflog.Debug(ctx, "Reading custom field data source", map[string]any{
+		"filters": where,
+	})
+
+	results, err := d.client.Get(ctx, "CustomField", where, customFieldDataSourceSelect)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading custom field",
+			"Could not read custom field: "+err.Error(),
+		)
+		return
+	}
+
+	if len(results) == 0 {
+		resp.Diagnostics.AddError(
+			"Custom field not found",
+			"No custom field named '"+config.Name.ValueString()+"' found in the specified custom group.",
+		)
+		return
+	}
+
+	result := results[0]
+
+	// Update state
+	if id, ok := GetInt64(result, "id"); ok {
+		config.ID = types.Int64Value(id)
+	}
+
+	if customGroupID, ok := GetInt64(result, "custom_group_id"); ok {
+		config.CustomGroupID = types.Int64Value(customGroupID)
+	}
+
+	if customGroupName, ok := GetString(result, "custom_group_id:name"); ok {
+		config.CustomGroupName = types.StringValue(customGroupName)
+	}
+
+	if label, ok := GetString(result, "label"); ok {
+		config.Label = types.StringValue(label)
+	}
+
+	if columnName, ok := GetString(result, "column_name"); ok {
+		config.ColumnName = types.StringValue(columnName)
+	}
+
+	if optionGroupID, ok := GetInt64(result, "option_group_id"); ok {
+		config.OptionGroupID = types.Int64Value(optionGroupID)
+	} else {
+		config.OptionGroupID = types.Int64Null()
+	}
+
+	if dataType, ok := GetString(result, "data_type"); ok {
+		config.DataType = types.StringValue(dataType)
+	}
+
+	if htmlType, ok := GetString(result, "html_type"); ok {
+		config.HtmlType = types.StringValue(htmlType)
+	}
+
+	if active, ok := GetBool(result, "is_active"); ok {
+		config.IsActive = types.BoolValue(active)
+	}
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
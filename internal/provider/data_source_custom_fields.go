@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &CustomFieldsDataSource{}
+var _ datasource.DataSourceWithConfigure = &CustomFieldsDataSource{}
+
+// CustomFieldsDataSource lists all fields of a custom group.
+type CustomFieldsDataSource struct {
+	client *Client
+}
+
+type CustomFieldsDataSourceModel struct {
+	CustomGroupID   types.Int64             `tfsdk:"custom_group_id"`
+	CustomGroupName types.String            `tfsdk:"custom_group_name"`
+	TableName       types.String            `tfsdk:"table_name"`
+	Limit           types.Int64             `tfsdk:"limit"`
+	Fields          []CustomFieldsItemModel `tfsdk:"fields"`
+}
+
+type CustomFieldsItemModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Label           types.String `tfsdk:"label"`
+	APIName         types.String `tfsdk:"api_name"`
+	ColumnName      types.String `tfsdk:"column_name"`
+	OptionGroupID   types.Int64  `tfsdk:"option_group_id"`
+	OptionGroupName types.String `tfsdk:"option_group_name"`
+	DataType        types.String `tfsdk:"data_type"`
+	HtmlType        types.String `tfsdk:"html_type"`
+	Weight          types.Int64  `tfsdk:"weight"`
+	IsActive        types.Bool   `tfsdk:"is_active"`
+}
+
+func NewCustomFieldsDataSource() datasource.DataSource {
+	return &CustomFieldsDataSource{}
+}
+
+func (d *CustomFieldsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_fields"
+}
+
+func (d *CustomFieldsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all fields of a CiviCRM custom group, active or not.",
+		Attributes: map[string]schema.Attribute{
+			"custom_group_id": schema.Int64Attribute{
+				Description: "The ID of the custom group. Specify either custom_group_id or custom_group_name.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("custom_group_name")),
+				},
+			},
+			"custom_group_name": schema.StringAttribute{
+				Description: "The machine name of the custom group. Specify either custom_group_id or custom_group_name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"table_name": schema.StringAttribute{
+				Description: "The database table that stores the values of the custom group.",
+				Computed:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of custom fields to list. Default: all of them.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"fields": schema.ListNestedAttribute{
+				Description: "The fields of the custom group, ordered by weight.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "The ID of the custom field.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The machine name of the custom field.",
+							Computed:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "The display label of the custom field.",
+							Computed:    true,
+						},
+						"api_name": schema.StringAttribute{
+							Description: "The name of the field in API v4 and SearchKit: the custom group name and field name joined by '.'.",
+							Computed:    true,
+						},
+						"column_name": schema.StringAttribute{
+							Description: "The database column that stores the values of the custom field.",
+							Computed:    true,
+						},
+						"option_group_id": schema.Int64Attribute{
+							Description: "The ID of the option group holding the options of the custom field, if it has options.",
+							Computed:    true,
+						},
+						"option_group_name": schema.StringAttribute{
+							Description: "The machine name of the option group holding the options of the custom field, if it has options.",
+							Computed:    true,
+						},
+						"data_type": schema.StringAttribute{
+							Description: "The data type of the custom field (e.g., 'String', 'Int', 'Date').",
+							Computed:    true,
+						},
+						"html_type": schema.StringAttribute{
+							Description: "The input type of the custom field (e.g., 'Text', 'Select', 'Radio').",
+							Computed:    true,
+						},
+						"weight": schema.Int64Attribute{
+							Description: "The sort weight of the custom field.",
+							Computed:    true,
+						},
+						"is_active": schema.BoolAttribute{
+							Description: "Whether the custom field is active.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CustomFieldsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CustomFieldsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config CustomFieldsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resolve the custom group, so that an unknown group is an error rather
+	// than an empty list
+	var groupWhere [][]any
+	if !config.CustomGroupID.IsNull() {
+		groupWhere = [][]any{{"id", "=", config.CustomGroupID.ValueInt64()}}
+	} else {
+		groupWhere = [][]any{{"name", "=", config.CustomGroupName.ValueString()}}
+	}
+
+	tflog.Debug(ctx, "Reading custom fields data source", map[string]any{
+		"filters": groupWhere,
+	})
+
+	groups, err := d.client.Get(ctx, "CustomGroup", groupWhere, []string{"id", "name", "table_name"})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading custom fields",
+			"Could not read custom group: "+err.Error(),
+		)
+		return
+	}
+
+	if len(groups) == 0 {
+		resp.Diagnostics.AddError(
+			"Custom group not found",
+			"No custom group found matching the specified criteria.",
+		)
+		return
+	}
+
+	groupID, _ := GetInt64(groups[0], "id")
+	groupName, _ := GetString(groups[0], "name")
+	config.CustomGroupID = types.Int64Value(groupID)
+	config.CustomGroupName = types.StringValue(groupName)
+	if tableName, ok := GetString(groups[0], "table_name"); ok {
+		config.TableName = types.StringValue(tableName)
+	} else {
+		config.TableName = types.StringNull()
+	}
+
+	results, err := d.client.GetLimited(ctx, "CustomField", [][]any{
+		{"custom_group_id", "=", groupID},
+	}, []string{"id", "name", "label", "column_name", "option_group_id", "option_group_id:name", "data_type", "html_type", "weight", "is_active"},
+		[]string{"weight"}, int(config.Limit.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading custom fields",
+			"Could not read custom fields: "+err.Error(),
+		)
+		return
+	}
+
+	config.Fields = make([]CustomFieldsItemModel, 0, len(results))
+	for _, result := range results {
+		field := CustomFieldsItemModel{
+			ID:              types.Int64Null(),
+			Name:            types.StringNull(),
+			Label:           types.StringNull(),
+			APIName:         types.StringNull(),
+			ColumnName:      types.StringNull(),
+			OptionGroupID:   types.Int64Null(),
+			OptionGroupName: types.StringNull(),
+			DataType:        types.StringNull(),
+			HtmlType:        types.StringNull(),
+			Weight:          types.Int64Null(),
+			IsActive:        types.BoolNull(),
+		}
+
+		if id, ok := GetInt64(result, "id"); ok {
+			field.ID = types.Int64Value(id)
+		}
+
+		if name, ok := GetString(result, "name"); ok {
+			field.Name = types.StringValue(name)
+			field.APIName = types.StringValue(groupName + "." + name)
+		}
+
+		if label, ok := GetString(result, "label"); ok {
+			field.Label = types.StringValue(label)
+		}
+
+		if columnName, ok := GetString(result, "column_name"); ok {
+			field.ColumnName = types.StringValue(columnName)
+		}
+
+		if optionGroupID, ok := GetInt64(result, "option_group_id"); ok {
+			field.OptionGroupID = types.Int64Value(optionGroupID)
+		}
+
+		if optionGroupName, ok := GetString(result, "option_group_id:name"); ok {
+			field.OptionGroupName = types.StringValue(optionGroupName)
+		}
+
+		if dataType, ok := GetString(result, "data_type"); ok {
+			field.DataType = types.StringValue(dataType)
+		}
+
+		if htmlType, ok := GetString(result, "html_type"); ok {
+			field.HtmlType = types.StringValue(htmlType)
+		}
+
+		if weight, ok := GetInt64(result, "weight"); ok {
+			field.Weight = types.Int64Value(weight)
+		}
+
+		if active, ok := GetBool(result, "is_active"); ok {
+			field.IsActive = types.BoolValue(active)
+		}
+
+		config.Fields = append(config.Fields, field)
+	}
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &EntityFieldsDataSource{}
+var _ datasource.DataSourceWithConfigure = &EntityFieldsDataSource{}
+
+// EntityFieldsDataSource exposes the getFields metadata of an API v4 entity,
+// including custom fields and the options of fields with a pseudoconstant.
+type EntityFieldsDataSource struct {
+	client *Client
+}
+
+type EntityFieldsDataSourceModel struct {
+	Entity     types.String            `tfsdk:"entity"`
+	Action     types.String            `tfsdk:"action"`
+	FieldNames []types.String          `tfsdk:"field_names"`
+	Fields     []EntityFieldsItemModel `tfsdk:"fields"`
+}
+
+type EntityFieldsItemModel struct {
+	Name        types.String             `tfsdk:"name"`
+	Title       types.String             `tfsdk:"title"`
+	Label       types.String             `tfsdk:"label"`
+	Description types.String             `tfsdk:"description"`
+	Type        types.String             `tfsdk:"type"`
+	DataType    types.String             `tfsdk:"data_type"`
+	InputType   types.String             `tfsdk:"input_type"`
+	Required    types.Bool               `tfsdk:"required"`
+	Readonly    types.Bool               `tfsdk:"readonly"`
+	Options     []EntityFieldOptionModel `tfsdk:"options"`
+}
+
+type EntityFieldOptionModel struct {
+	ID    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Label types.String `tfsdk:"label"`
+}
+
+func NewEntityFieldsDataSource() datasource.DataSource {
+	return &EntityFieldsDataSource{}
+}
+
+func (d *EntityFieldsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entity_fields"
+}
+
+func (d *EntityFieldsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the fields of a CiviCRM API v4 entity with their data types and options, as reported by getFields.",
+		Attributes: map[string]schema.Attribute{
+			"entity": schema.StringAttribute{
+				Description: "The API v4 entity (e.g., 'Contact', 'Activity').",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"action": schema.StringAttribute{
+				Description: "The action the fields are listed for (e.g., 'get', 'create'). Defaults to 'get'.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"field_names": schema.ListAttribute{
+				Description: "The names of the fields, for use with contains().",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"fields": schema.ListNestedAttribute{
+				Description: "The fields of the entity, in the order returned by getFields.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the field. Custom fields are named 'custom_group.custom_field'.",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "The title of the field.",
+							Computed:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "The form label of the field.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the field.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The kind of field: 'Field', 'Custom', 'Filter' or 'Extra'.",
+							Computed:    true,
+						},
+						"data_type": schema.StringAttribute{
+							Description: "The data type of the field (e.g., 'String', 'Integer', 'Boolean', 'Date').",
+							Computed:    true,
+						},
+						"input_type": schema.StringAttribute{
+							Description: "The form widget of the field (e.g., 'Text', 'Select', 'EntityRef').",
+							Computed:    true,
+						},
+						"required": schema.BoolAttribute{
+							Description: "Whether the field is required for the action.",
+							Computed:    true,
+						},
+						"readonly": schema.BoolAttribute{
+							Description: "Whether the field is read-only.",
+							Computed:    true,
+						},
+						"options": schema.ListNestedAttribute{
+							Description: "The options of the field. Empty for fields without options.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "The stored value of the option.",
+										Computed:    true,
+									},
+									"name": schema.StringAttribute{
+										Description: "The machine name of the option.",
+										Computed:    true,
+									},
+									"label": schema.StringAttribute{
+										Description: "The display label of the option.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *EntityFieldsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *EntityFieldsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config EntityFieldsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Action.IsNull() {
+		config.Action = types.StringValue("get")
+	}
+
+	tflog.Debug(ctx, "Reading entity fields data source", map[string]any{
+		"entity": config.Entity.ValueString(),
+		"action": config.Action.ValueString(),
+	})
+
+	results, err := d.client.GetFields(ctx, config.Entity.ValueString(), config.Action.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading entity fields",
+			"Could not read fields of "+config.Entity.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	config.FieldNames = make([]types.String, 0, len(results))
+	config.Fields = make([]EntityFieldsItemModel, 0, len(results))
+	for _, result := range results {
+		field := EntityFieldsItemModel{
+			Name:        types.StringNull(),
+			Title:       types.StringNull(),
+			Label:       types.StringNull(),
+			Description: types.StringNull(),
+			Type:        types.StringNull(),
+			DataType:    types.StringNull(),
+			InputType:   types.StringNull(),
+			Required:    types.BoolNull(),
+			Readonly:    types.BoolNull(),
+			Options:     []EntityFieldOptionModel{},
+		}
+
+		if name, ok := GetString(result, "name"); ok {
+			field.Name = types.StringValue(name)
+			config.FieldNames = append(config.FieldNames, field.Name)
+		}
+
+		if title, ok := GetString(result, "title"); ok {
+			field.Title = types.StringValue(title)
+		}
+
+		if label, ok := GetString(result, "label"); ok {
+			field.Label = types.StringValue(label)
+		}
+
+		if description, ok := GetString(result, "description"); ok && description != "" {
+			field.Description = types.StringValue(description)
+		}
+
+		if fieldType, ok := GetString(result, "type"); ok {
+			field.Type = types.StringValue(fieldType)
+		}
+
+		if dataType, ok := GetString(result, "data_type"); ok {
+			field.DataType = types.StringValue(dataType)
+		}
+
+		if inputType, ok := GetString(result, "input_type"); ok {
+			field.InputType = types.StringValue(inputType)
+		}
+
+		if required, ok := GetBool(result, "required"); ok {
+			field.Required = types.BoolValue(required)
+		}
+
+		if readonly, ok := GetBool(result, "readonly"); ok {
+			field.Readonly = types.BoolValue(readonly)
+		}
+
+		// Fields without options report false instead of a list
+		if options, ok := result["options"].([]any); ok {
+			for _, o := range options {
+				opt, ok := o.(map[string]any)
+				if !ok {
+					continue
+				}
+				field.Options = append(field.Options, entityFieldOptionFromResult(opt))
+			}
+		}
+
+		config.Fields = append(config.Fields, field)
+	}
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// entityFieldOptionFromResult maps an option loaded by getFields. The id is
+// numeric for most pseudoconstants but a string for some option groups.
+func entityFieldOptionFromResult(result map[string]any) EntityFieldOptionModel {
+	option := EntityFieldOptionModel{
+		ID:    types.StringNull(),
+		Name:  types.StringNull(),
+		Label: types.StringNull(),
+	}
+
+	if id, ok := GetString(result, "id"); ok {
+		option.ID = types.StringValue(id)
+	} else if id, ok := GetInt64(result, "id"); ok {
+		option.ID = types.StringValue(strconv.FormatInt(id, 10))
+	}
+
+	if name, ok := GetString(result, "name"); ok {
+		option.Name = types.StringValue(name)
+	}
+
+	if label, ok := GetString(result, "label"); ok {
+		option.Label = types.StringValue(label)
+	}
+
+	return option
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &ExtensionsDataSource{}
+var _ datasource.DataSourceWithConfigure = &ExtensionsDataSource{}
+
+// ExtensionsDataSource lists the extensions known to the server.
+type ExtensionsDataSource struct {
+	client *Client
+}
+
+type ExtensionsDataSourceModel struct {
+	Status     types.String          `tfsdk:"status"`
+	Keys       []types.String        `tfsdk:"keys"`
+	Extensions []ExtensionsItemModel `tfsdk:"extensions"`
+}
+
+type ExtensionsItemModel struct {
+	Key     types.String `tfsdk:"key"`
+	Name    types.String `tfsdk:"name"`
+	Label   types.String `tfsdk:"label"`
+	Version types.String `tfsdk:"version"`
+	Status  types.String `tfsdk:"status"`
+}
+
+func NewExtensionsDataSource() datasource.DataSource {
+	return &ExtensionsDataSource{}
+}
+
+func (d *ExtensionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_extensions"
+}
+
+func (d *ExtensionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the CiviCRM extensions known to the server, with their version and status.",
+		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				Description: "Only list extensions with this status. Options: 'installed' (installed and enabled), 'disabled', 'uninstalled'. Lists all extensions when not set.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("installed", "disabled", "uninstalled"),
+				},
+			},
+			"keys": schema.ListAttribute{
+				Description: "The keys of the listed extensions, for use with contains().",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"extensions": schema.ListNestedAttribute{
+				Description: "The listed extensions, ordered by key.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Description: "The key of the extension (e.g., 'org.civicrm.search_kit').",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The short name of the extension (e.g., 'search_kit').",
+							Computed:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "The display label of the extension.",
+							Computed:    true,
+						},
+						"version": schema.StringAttribute{
+							Description: "The version of the extension code on the server.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the extension (e.g., 'installed', 'disabled', 'uninstalled').",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ExtensionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ExtensionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ExtensionsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading extensions data source", map[string]any{
+		"status": config.Status.ValueString(),
+	})
+
+	results, err := d.client.GetExtensions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading extensions",
+			"Could not read extensions: "+err.Error(),
+		)
+		return
+	}
+
+	slices.SortFunc(results, func(a, b map[string]any) int {
+		keyA, _ := GetString(a, "key")
+		keyB, _ := GetString(b, "key")
+		return strings.Compare(keyA, keyB)
+	})
+
+	config.Keys = []types.String{}
+	config.Extensions = []ExtensionsItemModel{}
+	for _, result := range results {
+		status, _ := GetString(result, "status")
+		if !config.Status.IsNull() && status != config.Status.ValueString() {
+			continue
+		}
+
+		extension := ExtensionsItemModel{
+			Key:     types.StringNull(),
+			Name:    types.StringNull(),
+			Label:   types.StringNull(),
+			Version: types.StringNull(),
+			Status:  types.StringValue(status),
+		}
+
+		if key, ok := GetString(result, "key"); ok {
+			extension.Key = types.StringValue(key)
+			config.Keys = append(config.Keys, extension.Key)
+		}
+
+		if name, ok := GetString(result, "name"); ok {
+			extension.Name = types.StringValue(name)
+		}
+
+		if label, ok := GetString(result, "label"); ok {
+			extension.Label = types.StringValue(label)
+		}
+
+		if version, ok := GetString(result, "version"); ok {
+			extension.Version = types.StringValue(version)
+		}
+
+		config.Extensions = append(config.Extensions, extension)
+	}
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -114,7 +114,7 @@ func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		"filters": where,
 	})
 
-	results, err := d.client.Get("Group", where, nil)
+	results, err := d.client.Get(ctx, "Group", where, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading group",
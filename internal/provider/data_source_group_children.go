@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &GroupChildrenDataSource{}
+var _ datasource.DataSourceWithConfigure = &GroupChildrenDataSource{}
+
+// GroupChildrenDataSource lists the groups nested directly below a group.
+type GroupChildrenDataSource struct {
+	client *Client
+}
+
+type GroupChildrenDataSourceModel struct {
+	GroupID  types.Int64       `tfsdk:"group_id"`
+	Children []GroupChildModel `tfsdk:"children"`
+}
+
+type GroupChildModel struct {
+	ID    types.Int64  `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Title types.String `tfsdk:"title"`
+}
+
+func NewGroupChildrenDataSource() datasource.DataSource {
+	return &GroupChildrenDataSource{}
+}
+
+func (d *GroupChildrenDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_children"
+}
+
+func (d *GroupChildrenDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the child groups nested directly below a CiviCRM Group.",
+		Attributes: map[string]schema.Attribute{
+			"group_id": schema.Int64Attribute{
+				Description: "The ID of the parent group.",
+				Required:    true,
+			},
+			"children": schema.ListNestedAttribute{
+				Description: "The direct child groups, ordered by ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "The ID of the child group.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The machine name of the child group.",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "The display title of the child group.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GroupChildrenDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GroupChildrenDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config GroupChildrenDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading group children data source", map[string]any{
+		"group_id": config.GroupID.ValueInt64(),
+	})
+
+	results, err := d.client.GetAll(ctx, "GroupNesting", [][]any{
+		{"parent_group_id", "=", config.GroupID.ValueInt64()},
+	}, []string{"child_group_id", "child_group_id.name", "child_group_id.title"}, []string{"child_group_id"})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading group children",
+			"Could not read child groups of group ID "+strconv.FormatInt(config.GroupID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	config.Children = make([]GroupChildModel, 0, len(results))
+	for _, result := range results {
+		child := GroupChildModel{
+			ID:    types.Int64Null(),
+			Name:  types.StringNull(),
+			Title: types.StringNull(),
+		}
+
+		if id, ok := GetInt64(result, "child_group_id"); ok {
+			child.ID = types.Int64Value(id)
+		}
+
+		if name, ok := GetString(result, "child_group_id.name"); ok {
+			child.Name = types.StringValue(name)
+		}
+
+		if title, ok := GetString(result, "child_group_id.title"); ok {
+			child.Title = types.StringValue(title)
+		}
+
+		config.Children = append(config.Children, child)
+	}
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &GroupContactCountDataSource{}
+var _ datasource.DataSourceWithConfigure = &GroupContactCountDataSource{}
+
+// GroupContactCountDataSource counts the contacts in a group. Smart groups
+// are counted from their contact cache, which can be rebuilt first.
+type GroupContactCountDataSource struct {
+	client *Client
+}
+
+type GroupContactCountDataSourceModel struct {
+	GroupID      types.Int64 `tfsdk:"group_id"`
+	RefreshCache types.Bool  `tfsdk:"refresh_cache"`
+	IsSmart      types.Bool  `tfsdk:"is_smart"`
+	ContactCount types.Int64 `tfsdk:"contact_count"`
+}
+
+func NewGroupContactCountDataSource() datasource.DataSource {
+	return &GroupContactCountDataSource{}
+}
+
+func (d *GroupContactCountDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_contact_count"
+}
+
+func (d *GroupContactCountDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Counts the contacts in a CiviCRM group, including the members of smart groups.",
+		Attributes: map[string]schema.Attribute{
+			"group_id": schema.Int64Attribute{
+				Description: "The ID of the group.",
+				Required:    true,
+			},
+			"refresh_cache": schema.BoolAttribute{
+				Description: "Whether to rebuild the contact cache of a smart group before counting. Default: false.",
+				Optional:    true,
+			},
+			"is_smart": schema.BoolAttribute{
+				Description: "Whether the group is a smart group.",
+				Computed:    true,
+			},
+			"contact_count": schema.Int64Attribute{
+				Description: "The number of contacts in the group, not counting contacts in the trash.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *GroupContactCountDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GroupContactCountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config GroupContactCountDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID := config.GroupID.ValueInt64()
+
+	tflog.Debug(ctx, "Reading group contact count data source", map[string]any{
+		"group_id":      groupID,
+		"refresh_cache": config.RefreshCache.ValueBool(),
+	})
+
+	group, err := d.client.GetByID(ctx, "Group", groupID, []string{"id", "saved_search_id"})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading group contact count",
+			"Could not read group ID "+strconv.FormatInt(groupID, 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	_, isSmart := GetInt64(group, "saved_search_id")
+	config.IsSmart = types.BoolValue(isSmart)
+
+	if isSmart && config.RefreshCache.ValueBool() {
+		if err := d.client.RefreshGroup(ctx, groupID); err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading group contact count",
+				"Could not refresh the cache of group ID "+strconv.FormatInt(groupID, 10)+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// The groups filter of Contact covers both added members and the smart
+	// group cache, and leaves out contacts that were removed from the group
+	count, err := d.client.Count(ctx, "Contact", [][]any{
+		{"groups", "IN", []int64{groupID}},
+		{"is_deleted", "=", false},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading group contact count",
+			"Could not count contacts of group ID "+strconv.FormatInt(groupID, 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	config.ContactCount = types.Int64Value(count)
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
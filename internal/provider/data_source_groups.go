@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &GroupsDataSource{}
+var _ datasource.DataSourceWithConfigure = &GroupsDataSource{}
+
+// likeEscaper escapes the wildcards of a SQL LIKE pattern.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// GroupsDataSource lists the groups matching a set of filters.
+type GroupsDataSource struct {
+	client *Client
+}
+
+type GroupsDataSourceModel struct {
+	TitlePrefix types.String      `tfsdk:"title_prefix"`
+	GroupType   types.String      `tfsdk:"group_type"`
+	Visibility  types.String      `tfsdk:"visibility"`
+	IsActive    types.Bool        `tfsdk:"is_active"`
+	Limit       types.Int64       `tfsdk:"limit"`
+	Groups      []GroupsItemModel `tfsdk:"groups"`
+}
+
+type GroupsItemModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	IsActive    types.Bool   `tfsdk:"is_active"`
+	Visibility  types.String `tfsdk:"visibility"`
+	GroupType   types.List   `tfsdk:"group_type"`
+}
+
+func NewGroupsDataSource() datasource.DataSource {
+	return &GroupsDataSource{}
+}
+
+func (d *GroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_groups"
+}
+
+func (d *GroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the CiviCRM Groups matching all of the given filters.",
+		Attributes: map[string]schema.Attribute{
+			"title_prefix": schema.StringAttribute{
+				Description: "Only list groups whose title starts with this text.",
+				Optional:    true,
+			},
+			"group_type": schema.StringAttribute{
+				Description: "Only list groups of this type (e.g., 'Mailing List', 'Access Control', or the name of a group type created with civicrm_group_type).",
+				Optional:    true,
+			},
+			"visibility": schema.StringAttribute{
+				Description: "Only list groups with this visibility. Options: 'User and User Admin Only', 'Public Pages'.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("User and User Admin Only", "Public Pages"),
+				},
+			},
+			"is_active": schema.BoolAttribute{
+				Description: "Only list active (true) or inactive (false) groups.",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of groups to list. Default: all of them.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"groups": schema.ListNestedAttribute{
+				Description: "The matching groups, ordered by ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "The ID of the group.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The machine name of the group.",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "The display title of the group.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "A description of the group.",
+							Computed:    true,
+						},
+						"is_active": schema.BoolAttribute{
+							Description: "Whether the group is active.",
+							Computed:    true,
+						},
+						"visibility": schema.StringAttribute{
+							Description: "The visibility of the group.",
+							Computed:    true,
+						},
+						"group_type": schema.ListAttribute{
+							Description: "The names of the types of the group.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config GroupsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build where clause based on provided filters
+	where := [][]any{}
+	if !config.TitlePrefix.IsNull() {
+		where = append(where, []any{"title", "LIKE", likeEscaper.Replace(config.TitlePrefix.ValueString()) + "%"})
+	}
+	if !config.GroupType.IsNull() {
+		value, err := d.groupTypeValue(ctx, config.GroupType.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading groups",
+				"Could not resolve group_type: "+err.Error(),
+			)
+			return
+		}
+		where = append(where, []any{"group_type", "CONTAINS", value})
+	}
+	if !config.Visibility.IsNull() {
+		where = append(where, []any{"visibility", "=", config.Visibility.ValueString()})
+	}
+	if !config.IsActive.IsNull() {
+		where = append(where, []any{"is_active", "=", config.IsActive.ValueBool()})
+	}
+
+	tflog.Debug(ctx, "Reading groups data source", map[string]any{
+		"filters": where,
+	})
+
+	results, err := d.client.GetLimited(ctx, "Group", where,
+		[]string{"id", "name", "title", "description", "is_active", "visibility", "group_type:name"}, []string{"id"}, int(config.Limit.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading groups",
+			"Could not read groups: "+err.Error(),
+		)
+		return
+	}
+
+	config.Groups = make([]GroupsItemModel, 0, len(results))
+	for _, result := range results {
+		group := GroupsItemModel{
+			ID:          types.Int64Null(),
+			Name:        types.StringNull(),
+			Title:       types.StringNull(),
+			Description: types.StringNull(),
+			IsActive:    types.BoolNull(),
+			Visibility:  types.StringNull(),
+		}
+
+		if id, ok := GetInt64(result, "id"); ok {
+			group.ID = types.Int64Value(id)
+		}
+
+		if name, ok := GetString(result, "name"); ok {
+			group.Name = types.StringValue(name)
+		}
+
+		if title, ok := GetString(result, "title"); ok {
+			group.Title = types.StringValue(title)
+		}
+
+		if desc, ok := GetString(result, "description"); ok && desc != "" {
+			group.Description = types.StringValue(desc)
+		}
+
+		if active, ok := GetBool(result, "is_active"); ok {
+			group.IsActive = types.BoolValue(active)
+		}
+
+		if visibility, ok := GetString(result, "visibility"); ok {
+			group.Visibility = types.StringValue(visibility)
+		}
+
+		groupTypes := []string{}
+		if groupTypeSlice, ok := result["group_type:name"].([]any); ok {
+			for _, v := range groupTypeSlice {
+				if s, ok := v.(string); ok {
+					groupTypes = append(groupTypes, s)
+				}
+			}
+		}
+		groupTypeList, listDiags := types.ListValueFrom(ctx, types.StringType, groupTypes)
+		resp.Diagnostics.Append(listDiags...)
+		group.GroupType = groupTypeList
+
+		config.Groups = append(config.Groups, group)
+	}
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// groupTypeValue returns the value that groups store for the group type with
+// the given name.
+func (d *GroupsDataSource) groupTypeValue(ctx context.Context, name string) (string, error) {
+	if value, ok := groupTypeNameToID[name]; ok {
+		return value, nil
+	}
+
+	results, err := d.client.Get(ctx, "OptionValue", [][]any{
+		{"option_group_id:name", "=", "group_type"},
+		{"name", "=", name},
+	}, []string{"value"})
+	if err != nil {
+		return "", fmt.Errorf("failed to look up group type '%s': %w", name, err)
+	}
+
+	if len(results) == 0 {
+		return "", fmt.Errorf("group type '%s' not found", name)
+	}
+
+	value, _ := GetString(results[0], "value")
+	return value, nil
+}
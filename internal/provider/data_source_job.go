@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &JobDataSource{}
+var _ datasource.DataSourceWithConfigure = &JobDataSource{}
+
+// JobDataSource looks up a scheduled job by name or by the API call it runs.
+type JobDataSource struct {
+	client *Client
+}
+
+type JobDataSourceModel struct {
+	Name         types.String `tfsdk:"name"`
+	APIEntity    types.String `tfsdk:"api_entity"`
+	APIAction    types.String `tfsdk:"api_action"`
+	ID           types.Int64  `tfsdk:"id"`
+	Description  types.String `tfsdk:"description"`
+	RunFrequency types.String `tfsdk:"run_frequency"`
+	Parameters   types.String `tfsdk:"parameters"`
+	IsActive     types.Bool   `tfsdk:"is_active"`
+}
+
+func NewJobDataSource() datasource.DataSource {
+	return &JobDataSource{}
+}
+
+func (d *JobDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job"
+}
+
+func (d *JobDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a CiviCRM scheduled job by name or by the API entity and action it runs.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the job. Specify either name or api_entity and api_action.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("api_entity")),
+				},
+			},
+			"api_entity": schema.StringAttribute{
+				Description: "The API entity the job calls (e.g., 'Job'). Must be combined with api_action.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("api_action")),
+				},
+			},
+			"api_action": schema.StringAttribute{
+				Description: "The API action the job calls (e.g., 'process_mailing'). Must be combined with api_entity.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("api_entity")),
+				},
+			},
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier of the job.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the job.",
+				Computed:    true,
+			},
+			"run_frequency": schema.StringAttribute{
+				Description: "How often the job runs: 'Always', 'Hourly', 'Daily', 'Weekly', 'Monthly', 'Quarter' or 'Yearly'.",
+				Computed:    true,
+			},
+			"parameters": schema.StringAttribute{
+				Description: "The parameters passed to the API call, one 'key=value' per line.",
+				Computed:    true,
+			},
+			"is_active": schema.BoolAttribute{
+				Description: "Whether the job is active.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *JobDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *JobDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config JobDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build where clause based on provided filters
+	var where [][]any
+	if !config.Name.IsNull() {
+		where = [][]any{{"name", "=", config.Name.ValueString()}}
+	} else {
+		where = [][]any{
+			{"api_entity", "=", config.APIEntity.ValueString()},
+			{"api_action", "=", config.APIAction.ValueString()},
+		}
+	}
+
+	tflog.Debug(ctx, "Reading job data source", map[string]any{
+		"filters": where,
+	})
+
+	results, err := d.client.Get(ctx, "Job", where, []string{"id", "name", "description", "api_entity", "api_action", "run_frequency", "parameters", "is_active"})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading job",
+			"Could not read job: "+err.Error(),
+		)
+		return
+	}
+
+	if len(results) == 0 {
+		resp.Diagnostics.AddError(
+			"Job not found",
+			"No job found matching the specified criteria.",
+		)
+		return
+	}
+
+	if len(results) > 1 {
+		resp.Diagnostics.AddError(
+			"Multiple jobs found",
+			fmt.Sprintf("%d jobs match the specified criteria. Use name to select a single job.", len(results)),
+		)
+		return
+	}
+
+	result := results[0]
+
+	// Update state
+	if id, ok := GetInt64(result, "id"); ok {
+		config.ID = types.Int64Value(id)
+	}
+
+	if name, ok := GetString(result, "name"); ok {
+		config.Name = types.StringValue(name)
+	}
+
+	if apiEntity, ok := GetString(result, "api_entity"); ok {
+		config.APIEntity = types.StringValue(apiEntity)
+	}
+
+	if apiAction, ok := GetString(result, "api_action"); ok {
+		config.APIAction = types.StringValue(apiAction)
+	}
+
+	if desc, ok := GetString(result, "description"); ok && desc != "" {
+		config.Description = types.StringValue(desc)
+	} else {
+		config.Description = types.StringNull()
+	}
+
+	if runFrequency, ok := GetString(result, "run_frequency"); ok {
+		config.RunFrequency = types.StringValue(runFrequency)
+	}
+
+	if parameters, ok := GetString(result, "parameters"); ok && parameters != "" {
+		config.Parameters = types.StringValue(parameters)
+	} else {
+		config.Parameters = types.StringNull()
+	}
+
+	if active, ok := GetBool(result, "is_active"); ok {
+		config.IsActive = types.BoolValue(active)
+	}
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &LocationTypeDataSource{}
+var _ datasource.DataSourceWithConfigure = &LocationTypeDataSource{}
+
+// LocationTypeDataSource looks up a location type by name, or the default
+// location type.
+type LocationTypeDataSource struct {
+	client *Client
+}
+
+type LocationTypeDataSourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	IsDefault   types.Bool   `tfsdk:"is_default"`
+	ID          types.Int64  `tfsdk:"id"`
+	DisplayName types.String `tfsdk:"display_name"`
+	VcardName   types.String `tfsdk:"vcard_name"`
+	Description types.String `tfsdk:"description"`
+	IsReserved  types.Bool   `tfsdk:"is_reserved"`
+	IsActive    types.Bool   `tfsdk:"is_active"`
+}
+
+func NewLocationTypeDataSource() datasource.DataSource {
+	return &LocationTypeDataSource{}
+}
+
+func (d *LocationTypeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_location_type"
+}
+
+func (d *LocationTypeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a CiviCRM Location Type by name, or the default location type.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The machine name of the location type (e.g., 'Home', 'Work'). Specify either name or is_default.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("is_default")),
+				},
+			},
+			"is_default": schema.BoolAttribute{
+				Description: "Set to true to fetch the default location type. Specify either name or is_default.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier of the location type.",
+				Computed:    true,
+			},
+			"display_name": schema.StringAttribute{
+				Description: "The display name of the location type.",
+				Computed:    true,
+			},
+			"vcard_name": schema.StringAttribute{
+				Description: "The vCard name of the location type.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the location type.",
+				Computed:    true,
+			},
+			"is_reserved": schema.BoolAttribute{
+				Description: "Whether the location type is reserved.",
+				Computed:    true,
+			},
+			"is_active": schema.BoolAttribute{
+				Description: "Whether the location type is active.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *LocationTypeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *LocationTypeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config LocationTypeDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build where clause based on provided filters
+	var where [][]any
+	if !config.Name.IsNull() {
+		where = [][]any{{"name", "=", config.Name.ValueString()}}
+	} else {
+		if !config.IsDefault.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("is_default"),
+				"Invalid is_default",
+				"is_default can only be set to true. Use name to fetch another location type.",
+			)
+			return
+		}
+		where = [][]any{{"is_default", "=", true}}
+	}
+
+	tflog.Debug(ctx, "Reading location type data source", map[string]any{
+		"filters": where,
+	})
+
+	results, err := d.client.Get(ctx, "LocationType", where, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading location type",
+			"Could not read location type: "+err.Error(),
+		)
+		return
+	}
+
+	if len(results) == 0 {
+		resp.Diagnostics.AddError(
+			"Location type not found",
+			"No location type found matching the specified criteria.",
+		)
+		return
+	}
+
+	result := results[0]
+
+	// Update state
+	if id, ok := GetInt64(result, "id"); ok {
+		config.ID = types.Int64Value(id)
+	}
+
+	if name, ok := GetString(result, "name"); ok {
+		config.Name = types.StringValue(name)
+	}
+
+	if isDefault, ok := GetBool(result, "is_default"); ok {
+		config.IsDefault = types.BoolValue(isDefault)
+	}
+
+	if displayName, ok := GetString(result, "display_name"); ok && displayName != "" {
+		config.DisplayName = types.StringValue(displayName)
+	} else {
+		config.DisplayName = types.StringNull()
+	}
+
+	if vcardName, ok := GetString(result, "vcard_name"); ok && vcardName != "" {
+		config.VcardName = types.StringValue(vcardName)
+	} else {
+		config.VcardName = types.StringNull()
+	}
+
+	if desc, ok := GetString(result, "description"); ok && desc != "" {
+		config.Description = types.StringValue(desc)
+	} else {
+		config.Description = types.StringNull()
+	}
+
+	if reserved, ok := GetBool(result, "is_reserved"); ok {
+		config.IsReserved = types.BoolValue(reserved)
+	}
+
+	if active, ok := GetBool(result, "is_active"); ok {
+		config.IsActive = types.BoolValue(active)
+	}
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
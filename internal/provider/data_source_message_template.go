@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &MessageTemplateDataSource{}
+var _ datasource.DataSourceWithConfigure = &MessageTemplateDataSource{}
+
+type MessageTemplateDataSource struct {
+	client *Client
+}
+
+type MessageTemplateDataSourceModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	MsgTitle     types.String `tfsdk:"msg_title"`
+	WorkflowName types.String `tfsdk:"workflow_name"`
+	MsgSubject   types.String `tfsdk:"msg_subject"`
+	MsgText      types.String `tfsdk:"msg_text"`
+	MsgHTML      types.String `tfsdk:"msg_html"`
+	IsActive     types.Bool   `tfsdk:"is_active"`
+	IsDefault    types.Bool   `tfsdk:"is_default"`
+	IsReserved   types.Bool   `tfsdk:"is_reserved"`
+}
+
+func NewMessageTemplateDataSource() datasource.DataSource {
+	return &MessageTemplateDataSource{}
+}
+
+func (d *MessageTemplateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_message_template"
+}
+
+func (d *MessageTemplateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a CiviCRM Message Template by ID, title or workflow name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier of the message template. Specify one of id, msg_title or workflow_name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"msg_title": schema.StringAttribute{
+				Description: "The title of the message template. Specify one of id, msg_title or workflow_name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"workflow_name": schema.StringAttribute{
+				Description: "The workflow name of a system message template (e.g., 'contribution_online_receipt'). Only the default, editable copy of the template is matched. Specify one of id, msg_title or workflow_name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"msg_subject": schema.StringAttribute{
+				Description: "The subject of the message.",
+				Computed:    true,
+			},
+			"msg_text": schema.StringAttribute{
+				Description: "The plain text body of the message.",
+				Computed:    true,
+			},
+			"msg_html": schema.StringAttribute{
+				Description: "The HTML body of the message.",
+				Computed:    true,
+			},
+			"is_active": schema.BoolAttribute{
+				Description: "Whether the message template is active.",
+				Computed:    true,
+			},
+			"is_default": schema.BoolAttribute{
+				Description: "Whether this is the default copy of a system workflow template.",
+				Computed:    true,
+			},
+			"is_reserved": schema.BoolAttribute{
+				Description: "Whether this is the reserved, read-only copy of a system workflow template.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *MessageTemplateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *MessageTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config MessageTemplateDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build where clause based on provided filters
+	var where [][]any
+	if !config.ID.IsNull() {
+		where = append(where, []any{"id", "=", config.ID.ValueInt64()})
+	}
+	if !config.MsgTitle.IsNull() {
+		where = append(where, []any{"msg_title", "=", config.MsgTitle.ValueString()})
+	}
+	if !config.WorkflowName.IsNull() {
+		// System workflow templates exist as a reserved and a default copy
+		where = append(where, []any{"workflow_name", "=", config.WorkflowName.ValueString()})
+		where = append(where, []any{"is_default", "=", true})
+	}
+
+	if len(where) == 0 {
+		resp.Diagnostics.AddError(
+			"Missing Filter",
+			"At least one of 'id', 'msg_title' or 'workflow_name' must be specified.",
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Reading message template data source", map[string]any{
+		"filters": where,
+	})
+
+	results, err := d.client.Get(ctx, "MessageTemplate", where, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading message template",
+			"Could not read message template: "+err.Error(),
+		)
+		return
+	}
+
+	if len(results) == 0 {
+		resp.Diagnostics.AddError(
+			"Message template not found",
+			"No message template found matching the specified criteria.",
+		)
+		return
+	}
+
+	// Titles are not unique, so refuse to pick one of several matches
+	if len(results) > 1 {
+		resp.Diagnostics.AddError(
+			"Multiple message templates found",
+			fmt.Sprintf("%d message templates match the specified criteria. Use id to select one.", len(results)),
+		)
+		return
+	}
+
+	result := results[0]
+
+	// Update state
+	if id, ok := GetInt64(result, "id"); ok {
+		config.ID = types.Int64Value(id)
+	}
+
+	if title, ok := GetString(result, "msg_title"); ok {
+		config.MsgTitle = types.StringValue(title)
+	}
+
+	if workflowName, ok := GetString(result, "workflow_name"); ok && workflowName != "" {
+		config.WorkflowName = types.StringValue(workflowName)
+	} else {
+		config.WorkflowName = types.StringNull()
+	}
+
+	if subject, ok := GetString(result, "msg_subject"); ok && subject != "" {
+		config.MsgSubject = types.StringValue(subject)
+	} else {
+		config.MsgSubject = types.StringNull()
+	}
+
+	if text, ok := GetString(result, "msg_text"); ok && text != "" {
+		config.MsgText = types.StringValue(text)
+	} else {
+		config.MsgText = types.StringNull()
+	}
+
+	if html, ok := GetString(result, "msg_html"); ok && html != "" {
+		config.MsgHTML = types.StringValue(html)
+	} else {
+		config.MsgHTML = types.StringNull()
+	}
+
+	if active, ok := GetBool(result, "is_active"); ok {
+		config.IsActive = types.BoolValue(active)
+	}
+
+	if isDefault, ok := GetBool(result, "is_default"); ok {
+		config.IsDefault = types.BoolValue(isDefault)
+	}
+
+	if reserved, ok := GetBool(result, "is_reserved"); ok {
+		config.IsReserved = types.BoolValue(reserved)
+	}
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
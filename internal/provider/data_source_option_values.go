@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &OptionValuesDataSource{}
+var _ datasource.DataSourceWithConfigure = &OptionValuesDataSource{}
+
+// OptionValuesDataSource lists all values of an option group.
+type OptionValuesDataSource struct {
+	client *Client
+}
+
+type OptionValuesDataSourceModel struct {
+	OptionGroupID   types.Int64             `tfsdk:"option_group_id"`
+	OptionGroupName types.String            `tfsdk:"option_group_name"`
+	Limit           types.Int64             `tfsdk:"limit"`
+	Values          []OptionValuesItemModel `tfsdk:"values"`
+}
+
+type OptionValuesItemModel struct {
+	ID       types.Int64  `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Label    types.String `tfsdk:"label"`
+	Value    types.String `tfsdk:"value"`
+	Weight   types.Int64  `tfsdk:"weight"`
+	IsActive types.Bool   `tfsdk:"is_active"`
+}
+
+func NewOptionValuesDataSource() datasource.DataSource {
+	return &OptionValuesDataSource{}
+}
+
+func (d *OptionValuesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_option_values"
+}
+
+func (d *OptionValuesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all values of a CiviCRM option group, active or not.",
+		Attributes: map[string]schema.Attribute{
+			"option_group_id": schema.Int64Attribute{
+				Description: "The ID of the option group. Specify either option_group_id or option_group_name.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("option_group_name")),
+				},
+			},
+			"option_group_name": schema.StringAttribute{
+				Description: "The machine name of the option group (e.g., 'activity_type'). Specify either option_group_id or option_group_name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of option values to list. Default: all of them.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"values": schema.ListNestedAttribute{
+				Description: "The values of the option group, ordered by weight.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "The ID of the option value.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The machine name of the option value.",
+							Computed:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "The display label of the option value.",
+							Computed:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "The value stored on referencing records.",
+							Computed:    true,
+						},
+						"weight": schema.Int64Attribute{
+							Description: "The sort weight of the option value.",
+							Computed:    true,
+						},
+						"is_active": schema.BoolAttribute{
+							Description: "Whether the option value is active.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OptionValuesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OptionValuesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config OptionValuesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resolve the option group, so that an unknown group is an error rather
+	// than an empty list
+	var groupWhere [][]any
+	if !config.OptionGroupID.IsNull() {
+		groupWhere = [][]any{{"id", "=", config.OptionGroupID.ValueInt64()}}
+	} else {
+		groupWhere = [][]any{{"name", "=", config.OptionGroupName.ValueString()}}
+	}
+
+	tflog.Debug(ctx, "Reading option values data source", map[string]any{
+		"filters": groupWhere,
+	})
+
+	groups, err := d.client.Get(ctx, "OptionGroup", groupWhere, []string{"id", "name"})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading option values",
+			"Could not read option group: "+err.Error(),
+		)
+		return
+	}
+
+	if len(groups) == 0 {
+		resp.Diagnostics.AddError(
+			"Option group not found",
+			"No option group found matching the specified criteria.",
+		)
+		return
+	}
+
+	groupID, _ := GetInt64(groups[0], "id")
+	config.OptionGroupID = types.Int64Value(groupID)
+	if name, ok := GetString(groups[0], "name"); ok {
+		config.OptionGroupName = types.StringValue(name)
+	}
+
+	results, err := d.client.GetLimited(ctx, "OptionValue", [][]any{
+		{"option_group_id", "=", groupID},
+	}, []string{"id", "name", "label", "value", "weight", "is_active"}, []string{"weight", "id"}, int(config.Limit.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading option values",
+			"Could not read option values: "+err.Error(),
+		)
+		return
+	}
+
+	config.Values = make([]OptionValuesItemModel, 0, len(results))
+	for _, result := range results {
+		value := OptionValuesItemModel{
+			ID:       types.Int64Null(),
+			Name:     types.StringNull(),
+			Label:    types.StringNull(),
+			Value:    types.StringNull(),
+			Weight:   types.Int64Null(),
+			IsActive: types.BoolNull(),
+		}
+
+		if id, ok := GetInt64(result, "id"); ok {
+			value.ID = types.Int64Value(id)
+		}
+
+		if name, ok := GetString(result, "name"); ok {
+			value.Name = types.StringValue(name)
+		}
+
+		if label, ok := GetString(result, "label"); ok {
+			value.Label = types.StringValue(label)
+		}
+
+		if v, ok := GetString(result, "value"); ok {
+			value.Value = types.StringValue(v)
+		}
+
+		if weight, ok := GetInt64(result, "weight"); ok {
+			value.Weight = types.Int64Value(weight)
+		}
+
+		if active, ok := GetBool(result, "is_active"); ok {
+			value.IsActive = types.BoolValue(active)
+		}
+
+		config.Values = append(config.Values, value)
+	}
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
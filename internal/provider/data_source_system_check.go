@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &SystemCheckDataSource{}
+var _ datasource.DataSourceWithConfigure = &SystemCheckDataSource{}
+
+// SystemCheckDataSource runs the CiviCRM system status checks, so pipelines
+// can verify the installation after applying configuration changes.
+type SystemCheckDataSource struct {
+	client *Client
+}
+
+type SystemCheckDataSourceModel struct {
+	Checks []SystemCheckModel `tfsdk:"checks"`
+}
+
+type SystemCheckModel struct {
+	Name     types.String `tfsdk:"name"`
+	Severity types.String `tfsdk:"severity"`
+	Message  types.String `tfsdk:"message"`
+}
+
+func NewSystemCheckDataSource() datasource.DataSource {
+	return &SystemCheckDataSource{}
+}
+
+func (d *SystemCheckDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_check"
+}
+
+func (d *SystemCheckDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs the CiviCRM system status checks and returns the current status messages.",
+		Attributes: map[string]schema.Attribute{
+			"checks": schema.ListNestedAttribute{
+				Description: "The status messages reported by CiviCRM. Messages hidden by an administrator are left out.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the check (e.g., 'checkPhpVersion').",
+							Computed:    true,
+						},
+						"severity": schema.StringAttribute{
+							Description: "The severity of the message. One of 'debug', 'info', 'notice', 'warning', 'error', 'critical', 'alert', 'emergency'.",
+							Computed:    true,
+						},
+						"message": schema.StringAttribute{
+							Description: "The status message.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SystemCheckDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SystemCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config SystemCheckDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Running system checks")
+
+	results, err := d.client.SystemCheck(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error running system checks",
+			"Could not run system checks: "+err.Error(),
+		)
+		return
+	}
+
+	config.Checks = make([]SystemCheckModel, 0, len(results))
+	for _, result := range results {
+		check := SystemCheckModel{
+			Name:     types.StringNull(),
+			Severity: types.StringNull(),
+			Message:  types.StringNull(),
+		}
+
+		if name, ok := GetString(result, "name"); ok {
+			check.Name = types.StringValue(name)
+		}
+
+		if severity, ok := GetString(result, "severity"); ok {
+			check.Severity = types.StringValue(severity)
+		}
+
+		if message, ok := GetString(result, "message"); ok {
+			check.Message = types.StringValue(message)
+		}
+
+		config.Checks = append(config.Checks, check)
+	}
+
+	tflog.Debug(ctx, "Ran system checks", map[string]any{
+		"count": len(config.Checks),
+	})
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
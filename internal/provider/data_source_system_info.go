@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &SystemInfoDataSource{}
+var _ datasource.DataSourceWithConfigure = &SystemInfoDataSource{}
+
+// SystemInfoDataSource reports the versions and enabled components of the
+// CiviCRM installation, so configurations can depend on server features.
+type SystemInfoDataSource struct {
+	client *Client
+}
+
+type SystemInfoDataSourceModel struct {
+	Version           types.String   `tfsdk:"version"`
+	MajorVersion      types.Int64    `tfsdk:"major_version"`
+	MinorVersion      types.Int64    `tfsdk:"minor_version"`
+	CMS               types.String   `tfsdk:"cms"`
+	CMSVersion        types.String   `tfsdk:"cms_version"`
+	PHPVersion        types.String   `tfsdk:"php_version"`
+	EnabledComponents []types.String `tfsdk:"enabled_components"`
+}
+
+func NewSystemInfoDataSource() datasource.DataSource {
+	return &SystemInfoDataSource{}
+}
+
+func (d *SystemInfoDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_info"
+}
+
+func (d *SystemInfoDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reports the CiviCRM, CMS and PHP versions and the enabled components of the CiviCRM installation.",
+		Attributes: map[string]schema.Attribute{
+			"version": schema.StringAttribute{
+				Description: "The CiviCRM version (e.g., '5.78.2').",
+				Computed:    true,
+			},
+			"major_version": schema.Int64Attribute{
+				Description: "The major part of the CiviCRM version (e.g., 5).",
+				Computed:    true,
+			},
+			"minor_version": schema.Int64Attribute{
+				Description: "The minor part of the CiviCRM version (e.g., 78).",
+				Computed:    true,
+			},
+			"cms": schema.StringAttribute{
+				Description: "The CMS CiviCRM runs on (e.g., 'Drupal8', 'WordPress', 'Standalone').",
+				Computed:    true,
+			},
+			"cms_version": schema.StringAttribute{
+				Description: "The version of the CMS. Null when the API user may not see it.",
+				Computed:    true,
+			},
+			"php_version": schema.StringAttribute{
+				Description: "The PHP version of the server. Null when the API user may not see it.",
+				Computed:    true,
+			},
+			"enabled_components": schema.ListAttribute{
+				Description: "The enabled CiviCRM components (e.g., 'CiviEvent', 'CiviMember').",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *SystemInfoDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SystemInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading system info data source")
+
+	info, err := d.client.GetSystemInfo(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading system info",
+			"Could not read system info: "+err.Error(),
+		)
+		return
+	}
+
+	components, err := d.client.GetSetting(ctx, "enable_components", 0)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading system info",
+			"Could not read enabled components: "+err.Error(),
+		)
+		return
+	}
+
+	state := SystemInfoDataSourceModel{
+		Version:           types.StringNull(),
+		MajorVersion:      types.Int64Null(),
+		MinorVersion:      types.Int64Null(),
+		CMS:               types.StringNull(),
+		CMSVersion:        types.StringNull(),
+		PHPVersion:        types.StringNull(),
+		EnabledComponents: []types.String{},
+	}
+
+	civi, _ := info["civi"].(map[string]any)
+	if version, ok := GetString(civi, "version"); ok {
+		state.Version = types.StringValue(version)
+
+		parts := strings.SplitN(version, ".", 3)
+		if major, err := strconv.ParseInt(parts[0], 10, 64); err == nil {
+			state.MajorVersion = types.Int64Value(major)
+		}
+		if len(parts) > 1 {
+			if minor, err := strconv.ParseInt(parts[1], 10, 64); err == nil {
+				state.MinorVersion = types.Int64Value(minor)
+			}
+		}
+	}
+
+	cms, _ := info["cms"].(map[string]any)
+	if cmsType, ok := GetString(cms, "type"); ok {
+		state.CMS = types.StringValue(cmsType)
+	} else if uf, ok := GetString(info, "uf"); ok {
+		state.CMS = types.StringValue(uf)
+	}
+
+	if cmsVersion, ok := GetString(cms, "version"); ok && cmsVersion != "" {
+		state.CMSVersion = types.StringValue(cmsVersion)
+	}
+
+	php, _ := info["php"].(map[string]any)
+	if phpVersion, ok := GetString(php, "version"); ok && phpVersion != "" {
+		state.PHPVersion = types.StringValue(phpVersion)
+	}
+
+	if componentSlice, ok := components.([]any); ok {
+		for _, v := range componentSlice {
+			if s, ok := v.(string); ok {
+				state.EnabledComponents = append(state.EnabledComponents, types.StringValue(s))
+			}
+		}
+	}
+
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,331 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &TagsDataSource{}
+var _ datasource.DataSourceWithConfigure = &TagsDataSource{}
+
+// TagsDataSource lists the tags matching a set of filters, together with their
+// position in the tag tree.
+type TagsDataSource struct {
+	client *Client
+}
+
+type TagsDataSourceModel struct {
+	UsedFor  types.String    `tfsdk:"used_for"`
+	ParentID types.Int64     `tfsdk:"parent_id"`
+	IsTagset types.Bool      `tfsdk:"is_tagset"`
+	Tags     []TagsItemModel `tfsdk:"tags"`
+}
+
+type TagsItemModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Label        types.String `tfsdk:"label"`
+	Description  types.String `tfsdk:"description"`
+	ParentID     types.Int64  `tfsdk:"parent_id"`
+	IsSelectable types.Bool   `tfsdk:"is_selectable"`
+	IsReserved   types.Bool   `tfsdk:"is_reserved"`
+	IsTagset     types.Bool   `tfsdk:"is_tagset"`
+	UsedFor      types.List   `tfsdk:"used_for"`
+	Color        types.String `tfsdk:"color"`
+	Depth        types.Int64  `tfsdk:"depth"`
+	Path         types.String `tfsdk:"path"`
+}
+
+// tagNode is a tag of the tag tree built by TagsDataSource.Read.
+type tagNode struct {
+	result   map[string]any
+	id       int64
+	name     string
+	parentID int64
+	children []*tagNode
+}
+
+func NewTagsDataSource() datasource.DataSource {
+	return &TagsDataSource{}
+}
+
+func (d *TagsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tags"
+}
+
+func (d *TagsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the CiviCRM Tags matching all of the given filters, in tag tree order.",
+		Attributes: map[string]schema.Attribute{
+			"used_for": schema.StringAttribute{
+				Description: "Only list tags that can be used for this entity type (e.g., 'civicrm_contact', 'civicrm_activity').",
+				Optional:    true,
+			},
+			"parent_id": schema.Int64Attribute{
+				Description: "Only list the tags below this tag, at any depth.",
+				Optional:    true,
+			},
+			"is_tagset": schema.BoolAttribute{
+				Description: "Only list tagsets (true) or only ordinary tags (false).",
+				Optional:    true,
+			},
+			"tags": schema.ListNestedAttribute{
+				Description: "The matching tags, depth first: each tag is followed by its descendants, and siblings are ordered by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "The ID of the tag.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The machine name of the tag.",
+							Computed:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "The display label of the tag.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the tag.",
+							Computed:    true,
+						},
+						"parent_id": schema.Int64Attribute{
+							Description: "The ID of the parent tag. Null for top-level tags.",
+							Computed:    true,
+						},
+						"is_selectable": schema.BoolAttribute{
+							Description: "Whether the tag can be selected.",
+							Computed:    true,
+						},
+						"is_reserved": schema.BoolAttribute{
+							Description: "Whether the tag is reserved.",
+							Computed:    true,
+						},
+						"is_tagset": schema.BoolAttribute{
+							Description: "Whether the tag is a tagset.",
+							Computed:    true,
+						},
+						"used_for": schema.ListAttribute{
+							Description: "The entity types the tag can be used for.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"color": schema.StringAttribute{
+							Description: "The color of the tag in hex format.",
+							Computed:    true,
+						},
+						"depth": schema.Int64Attribute{
+							Description: "The level of the tag in the tag tree, starting at 0 for top-level tags.",
+							Computed:    true,
+						},
+						"path": schema.StringAttribute{
+							Description: "The names of the ancestors of the tag and the tag itself, joined by '/'.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TagsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config TagsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading tags data source", map[string]any{
+		"used_for":  config.UsedFor.ValueString(),
+		"parent_id": config.ParentID.ValueInt64(),
+		"is_tagset": config.IsTagset.ValueBool(),
+	})
+
+	// The filters are applied locally: depth, path and parent_id need the
+	// whole tree, and sites rarely have more than a few hundred tags
+	results, err := d.client.GetAll(ctx, "Tag", [][]any{},
+		[]string{"id", "name", "label", "description", "parent_id", "is_selectable", "is_reserved", "is_tagset", "used_for", "color"},
+		[]string{"name"})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading tags",
+			"Could not read tags: "+err.Error(),
+		)
+		return
+	}
+
+	nodes := make(map[int64]*tagNode, len(results))
+	var ordered []*tagNode
+	for _, result := range results {
+		node := &tagNode{result: result}
+		node.id, _ = GetInt64(result, "id")
+		node.name, _ = GetString(result, "name")
+		node.parentID, _ = GetInt64(result, "parent_id")
+		nodes[node.id] = node
+		ordered = append(ordered, node)
+	}
+
+	// Results are ordered by name, so children are too
+	var roots []*tagNode
+	for _, node := range ordered {
+		if parent, ok := nodes[node.parentID]; ok && node.parentID != 0 {
+			parent.children = append(parent.children, node)
+		} else {
+			roots = append(roots, node)
+		}
+	}
+
+	startDepth := int64(0)
+	var startPath []string
+	if !config.ParentID.IsNull() {
+		parent, ok := nodes[config.ParentID.ValueInt64()]
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Tag not found",
+				"No tag found with ID "+strconv.FormatInt(config.ParentID.ValueInt64(), 10)+".",
+			)
+			return
+		}
+		roots = parent.children
+		// The length check stops at a parent_id cycle in a damaged tree
+		for p := parent; p != nil && startDepth < int64(len(nodes)); p = nodes[p.parentID] {
+			startPath = append([]string{p.name}, startPath...)
+			startDepth++
+		}
+	}
+
+	config.Tags = []TagsItemModel{}
+	var walk func(node *tagNode, depth int64, path []string)
+	walk = func(node *tagNode, depth int64, path []string) {
+		path = append(path[:len(path):len(path)], node.name)
+		if d.tagMatches(node.result, config) {
+			tag := d.tagFromResult(ctx, node.result, resp)
+			tag.Depth = types.Int64Value(depth)
+			tag.Path = types.StringValue(strings.Join(path, "/"))
+			config.Tags = append(config.Tags, tag)
+		}
+		for _, child := range node.children {
+			walk(child, depth+1, path)
+		}
+	}
+	for _, root := range roots {
+		walk(root, startDepth, startPath)
+	}
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// tagMatches reports whether a tag passes the used_for and is_tagset filters.
+func (d *TagsDataSource) tagMatches(result map[string]any, config TagsDataSourceModel) bool {
+	if !config.IsTagset.IsNull() {
+		isTagset, _ := GetBool(result, "is_tagset")
+		if isTagset != config.IsTagset.ValueBool() {
+			return false
+		}
+	}
+
+	if !config.UsedFor.IsNull() {
+		usedFor, _ := result["used_for"].([]any)
+		if !slices.Contains(usedFor, any(config.UsedFor.ValueString())) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// tagFromResult maps a Tag API result to a list entry.
+func (d *TagsDataSource) tagFromResult(ctx context.Context, result map[string]any, resp *datasource.ReadResponse) TagsItemModel {
+	tag := TagsItemModel{
+		ID:           types.Int64Null(),
+		Name:         types.StringNull(),
+		Label:        types.StringNull(),
+		Description:  types.StringNull(),
+		ParentID:     types.Int64Null(),
+		IsSelectable: types.BoolNull(),
+		IsReserved:   types.BoolNull(),
+		IsTagset:     types.BoolNull(),
+		Color:        types.StringNull(),
+	}
+
+	if id, ok := GetInt64(result, "id"); ok {
+		tag.ID = types.Int64Value(id)
+	}
+
+	if name, ok := GetString(result, "name"); ok {
+		tag.Name = types.StringValue(name)
+	}
+
+	if label, ok := GetString(result, "label"); ok && label != "" {
+		tag.Label = types.StringValue(label)
+	} else {
+		tag.Label = tag.Name
+	}
+
+	if desc, ok := GetString(result, "description"); ok && desc != "" {
+		tag.Description = types.StringValue(desc)
+	}
+
+	if parentID, ok := GetInt64(result, "parent_id"); ok {
+		tag.ParentID = types.Int64Value(parentID)
+	}
+
+	if selectable, ok := GetBool(result, "is_selectable"); ok {
+		tag.IsSelectable = types.BoolValue(selectable)
+	}
+
+	if reserved, ok := GetBool(result, "is_reserved"); ok {
+		tag.IsReserved = types.BoolValue(reserved)
+	}
+
+	if tagset, ok := GetBool(result, "is_tagset"); ok {
+		tag.IsTagset = types.BoolValue(tagset)
+	}
+
+	if color, ok := GetString(result, "color"); ok && color != "" {
+		tag.Color = types.StringValue(color)
+	}
+
+	usedFor := []string{}
+	if usedForSlice, ok := result["used_for"].([]any); ok {
+		for _, v := range usedForSlice {
+			if s, ok := v.(string); ok {
+				usedFor = append(usedFor, s)
+			}
+		}
+	}
+	usedForList, listDiags := types.ListValueFrom(ctx, types.StringType, usedFor)
+	resp.Diagnostics.Append(listDiags...)
+	tag.UsedFor = usedForList
+
+	return tag
+}
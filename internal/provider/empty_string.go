@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// optionalString maps an optional string attribute from an API result.
+//
+// CiviCRM returns "" for most optional strings that are not set, so an empty
+// value is mapped to null to match a configuration that leaves the attribute
+// out. When the provider is configured with treat_empty_as_null = false, an
+// empty value is kept as "" if current (the planned or prior value) is "", so
+// that an explicitly empty attribute does not show up as drift.
+func (c *Client) optionalString(result map[string]any, key string, current types.String) types.String {
+	value, ok := GetString(result, key)
+	if ok && value != "" {
+		return types.StringValue(value)
+	}
+
+	if !c.treatEmptyAsNull && !current.IsNull() && !current.IsUnknown() && current.ValueString() == "" {
+		return types.StringValue("")
+	}
+
+	return types.StringNull()
+}
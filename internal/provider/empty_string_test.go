@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOptionalString(t *testing.T) {
+	tests := []struct {
+		name             string
+		result           map[string]any
+		current          types.String
+		treatEmptyAsNull bool
+		want             types.String
+	}{
+		{name: "value", result: map[string]any{"description": "Donors"}, current: types.StringNull(), treatEmptyAsNull: true, want: types.StringValue("Donors")},
+		{name: "changed value", result: map[string]any{"description": "Donors"}, current: types.StringValue("Members"), treatEmptyAsNull: false, want: types.StringValue("Donors")},
+		{name: "empty for null", result: map[string]any{"description": ""}, current: types.StringNull(), treatEmptyAsNull: true, want: types.StringNull()},
+		{name: "null for null", result: map[string]any{"description": nil}, current: types.StringNull(), treatEmptyAsNull: true, want: types.StringNull()},
+		{name: "missing for null", result: map[string]any{}, current: types.StringNull(), treatEmptyAsNull: true, want: types.StringNull()},
+		{name: "empty for empty", result: map[string]any{"description": ""}, current: types.StringValue(""), treatEmptyAsNull: true, want: types.StringNull()},
+		{name: "empty for empty kept", result: map[string]any{"description": ""}, current: types.StringValue(""), treatEmptyAsNull: false, want: types.StringValue("")},
+		{name: "null for empty kept", result: map[string]any{"description": nil}, current: types.StringValue(""), treatEmptyAsNull: false, want: types.StringValue("")},
+		{name: "empty for null kept", result: map[string]any{"description": ""}, current: types.StringNull(), treatEmptyAsNull: false, want: types.StringNull()},
+		{name: "empty for unknown kept", result: map[string]any{"description": ""}, current: types.StringUnknown(), treatEmptyAsNull: false, want: types.StringNull()},
+		{name: "empty for removed value kept", result: map[string]any{"description": ""}, current: types.StringValue("Donors"), treatEmptyAsNull: false, want: types.StringNull()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &Client{treatEmptyAsNull: tt.treatEmptyAsNull}
+			if got := client.optionalString(tt.result, "description", tt.current); !got.Equal(tt.want) {
+				t.Errorf("optionalString() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTagResourceEmptyDescription(t *testing.T) {
+	tests := []struct {
+		name             string
+		treatEmptyAsNull bool
+		want             types.String
+	}{
+		{name: "treated as null", treatEmptyAsNull: true, want: types.StringNull()},
+		{name: "kept", treatEmptyAsNull: false, want: types.StringValue("")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			record := map[string]any{
+				"id": 3, "name": "donor", "label": "Donor", "description": "", "parent_id": nil,
+				"is_selectable": true, "is_reserved": false, "is_tagset": false,
+				"used_for": []any{"civicrm_contact"}, "color": nil,
+			}
+			client := newTestClient(t, func(req testRequest) ([]map[string]any, error) {
+				switch req.Entity + "." + req.Action {
+				case "Tag.create", "Tag.get":
+					return []map[string]any{record}, nil
+				}
+				t.Errorf("unexpected request %s.%s", req.Entity, req.Action)
+				return nil, errors.New("unexpected request")
+			})
+			client.treatEmptyAsNull = tt.treatEmptyAsNull
+
+			r := &TagResource{}
+			s := newTestResource(t, r, client)
+
+			usedFor, _ := types.ListValueFrom(ctx, types.StringType, []string{"civicrm_contact"})
+			plan := TagResourceModel{
+				ID:                   types.Int64Unknown(),
+				Name:                 types.StringValue("donor"),
+				Label:                types.StringValue("Donor"),
+				Description:          types.StringValue(""),
+				ParentID:             types.Int64Null(),
+				IsSelectable:         types.BoolValue(true),
+				IsReserved:           types.BoolValue(false),
+				IsTagset:             types.BoolValue(false),
+				UsedFor:              usedFor,
+				Color:                types.StringNull(),
+				AllowReservedChanges: types.BoolValue(false),
+				IgnoreFields:         types.ListNull(types.StringType),
+			}
+
+			createResp := &resource.CreateResponse{State: emptyTestState(s)}
+			r.Create(ctx, resource.CreateRequest{Plan: testPlan(t, s, plan), Config: testConfig(t, s, plan)}, createResp)
+			if createResp.Diagnostics.HasError() {
+				t.Fatalf("Create: %v", createResp.Diagnostics)
+			}
+
+			readResp := &resource.ReadResponse{State: createResp.State}
+			r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+			if readResp.Diagnostics.HasError() {
+				t.Fatalf("Read: %v", readResp.Diagnostics)
+			}
+
+			for name, state := range map[string]tfsdk.State{
+				"Create": createResp.State,
+				"Read":   readResp.State,
+			} {
+				var model TagResourceModel
+				state.Get(ctx, &model)
+				if !model.Description.Equal(tt.want) {
+					t.Errorf("description after %s = %s, want %s", name, model.Description, tt.want)
+				}
+			}
+		})
+	}
+}
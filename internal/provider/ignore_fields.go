@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ignoreFieldsAttribute is the schema for the `ignore_fields` escape hatch,
+// which lets Terraform co-manage an entity with another tool by leaving the
+// listed attributes alone after creation.
+var ignoreFieldsAttribute = schema.ListAttribute{
+	Description: "Attributes that are managed outside of Terraform. They are only sent on create, are left out of updates, and keep their previous value on refresh.",
+	Optional:    true,
+	ElementType: types.StringType,
+}
+
+// attributeGetter is implemented by tfsdk.Plan and tfsdk.State.
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, p path.Path, target any) diag.Diagnostics
+}
+
+// ignoredFields returns the attribute names listed in an `ignore_fields` value.
+// The id and ignore_fields attributes themselves can never be ignored.
+func ignoredFields(ctx context.Context, list types.List, diags *diag.Diagnostics) []string {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+
+	var names []string
+	diags.Append(list.ElementsAs(ctx, &names, false)...)
+
+	fields := make([]string, 0, len(names))
+	for _, name := range names {
+		if name == "id" || name == "ignore_fields" {
+			diags.AddAttributeError(
+				path.Root("ignore_fields"),
+				"Invalid ignore_fields entry",
+				"The attribute '"+name+"' cannot be ignored.",
+			)
+			continue
+		}
+		fields = append(fields, name)
+	}
+
+	return fields
+}
+
+// validateIgnoredFields checks the `ignore_fields` entries in config against
+// the attributes of the resource schema, so that a typo fails the plan rather
+// than a later refresh or update.
+func validateIgnoredFields(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) {
+	var list types.List
+	diags.Append(config.GetAttribute(ctx, path.Root("ignore_fields"), &list)...)
+	if diags.HasError() || list.IsNull() || list.IsUnknown() {
+		return
+	}
+
+	attributes := config.Schema.GetAttributes()
+	for i, element := range list.Elements() {
+		name, ok := element.(types.String)
+		if !ok || name.IsNull() || name.IsUnknown() {
+			continue
+		}
+
+		if _, ok := attributes[name.ValueString()]; !ok {
+			diags.AddAttributeError(
+				path.Root("ignore_fields").AtListIndex(i),
+				"Invalid ignore_fields entry",
+				"The resource has no attribute '"+name.ValueString()+"'.",
+			)
+		} else if name.ValueString() == "id" || name.ValueString() == "ignore_fields" {
+			diags.AddAttributeError(
+				path.Root("ignore_fields").AtListIndex(i),
+				"Invalid ignore_fields entry",
+				"The attribute '"+name.ValueString()+"' cannot be ignored.",
+			)
+		}
+	}
+}
+
+// omitIgnoredFields removes the ignored attributes from an API values map.
+// apiNames maps the attributes that are sent under other API field names, such
+// as image_url as image_URL.
+func omitIgnoredFields(values map[string]any, fields []string, apiNames map[string][]string) {
+	for _, field := range fields {
+		names, ok := apiNames[field]
+		if !ok {
+			names = []string{field}
+		}
+		for _, name := range names {
+			delete(values, name)
+		}
+	}
+}
+
+// keepIgnoredFields copies the ignored attributes from source into target, so
+// that changes made outside of Terraform are not reported as drift. Unknown
+// source values, such as a computed attribute planned after an update, are
+// skipped so that target keeps the value read from CiviCRM.
+func keepIgnoredFields(ctx context.Context, fields []string, source attributeGetter, target *tfsdk.State, diags *diag.Diagnostics) {
+	for _, field := range fields {
+		var value attr.Value
+		diags.Append(source.GetAttribute(ctx, path.Root(field), &value)...)
+		if diags.HasError() {
+			return
+		}
+		if value.IsUnknown() {
+			continue
+		}
+		diags.Append(target.SetAttribute(ctx, path.Root(field), value)...)
+	}
+}
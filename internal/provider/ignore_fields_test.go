@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOmitIgnoredFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		fields   []string
+		apiNames map[string][]string
+		want     []string
+	}{
+		{name: "none", want: []string{"component_id:name", "description", "image_URL", "is_hidden", "title"}},
+		{name: "attribute", fields: []string{"is_hidden"}, want: []string{"component_id:name", "description", "image_URL", "title"}},
+		{name: "attributes", fields: []string{"is_hidden", "description"}, want: []string{"component_id:name", "image_URL", "title"}},
+		{name: "api name", fields: []string{"image_url"}, apiNames: contactTypeAPINames, want: []string{"component_id:name", "description", "is_hidden", "title"}},
+		{name: "api names", fields: []string{"component"}, apiNames: optionValueAPINames, want: []string{"description", "image_URL", "is_hidden", "title"}},
+		{name: "not sent", fields: []string{"parents"}, want: []string{"component_id:name", "description", "image_URL", "is_hidden", "title"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := map[string]any{
+				"title": "Newsletter", "description": nil, "is_hidden": false, "image_URL": nil,
+				"component_id:name": "CiviEvent",
+			}
+			omitIgnoredFields(values, tt.fields, tt.apiNames)
+			if len(values) != len(tt.want) {
+				t.Errorf("values = %v, want the keys %v", values, tt.want)
+			}
+			for _, key := range tt.want {
+				if _, ok := values[key]; !ok {
+					t.Errorf("values = %v, want the keys %v", values, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestGroupResourceIgnoreFields(t *testing.T) {
+	ctx := context.Background()
+
+	// is_hidden is managed by an extension, which has hidden the group
+	record := map[string]any{
+		"id": 12, "name": "newsletter", "title": "Newsletter", "description": nil,
+		"frontend_title": nil, "frontend_description": nil, "is_active": true,
+		"visibility": "User and User Admin Only", "group_type": []any{"2"}, "is_hidden": true,
+		"is_reserved": false, "parents": []any{},
+	}
+	var sent map[string]any
+	client := newTestClient(t, func(req testRequest) ([]map[string]any, error) {
+		switch req.Entity + "." + req.Action {
+		case "Group.update":
+			sent, _ = req.Params["values"].(map[string]any)
+			maps.Copy(record, sent)
+			return []map[string]any{record}, nil
+		case "Group.get":
+			return []map[string]any{record}, nil
+		}
+		t.Errorf("unexpected request %s.%s", req.Entity, req.Action)
+		return nil, errors.New("unexpected request")
+	})
+
+	r := &GroupResource{}
+	s := newTestResource(t, r, client)
+
+	groupType, _ := types.ListValueFrom(ctx, types.StringType, []string{"Mailing List"})
+	ignoreFields, _ := types.ListValueFrom(ctx, types.StringType, []string{"is_hidden"})
+	state := testState(t, s, GroupResourceModel{
+		ID:                  types.Int64Value(12),
+		Name:                types.StringValue("newsletter"),
+		Title:               types.StringValue("Newsletter"),
+		Description:         types.StringNull(),
+		IsActive:            types.BoolValue(true),
+		Visibility:          types.StringValue("User and User Admin Only"),
+		GroupType:           groupType,
+		IsHidden:            types.BoolValue(false),
+		IsReserved:          types.BoolValue(false),
+		FrontendTitle:       types.StringNull(),
+		FrontendDescription: types.StringNull(),
+		Parents:             types.ListNull(types.Int64Type),
+		IgnoreFields:        ignoreFields,
+	})
+
+	readResp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read: %v", readResp.Diagnostics)
+	}
+	if !readResp.State.Raw.Equal(state.Raw) {
+		t.Errorf("Read reported drift of an ignored attribute:\nprior: %s\nread:  %s", state.Raw, readResp.State.Raw)
+	}
+
+	var plan GroupResourceModel
+	readResp.State.Get(ctx, &plan)
+	plan.Title = types.StringValue("Monthly Newsletter")
+
+	planned := testPlan(t, s, plan)
+	updateResp := &resource.UpdateResponse{State: readResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: planned, Config: testConfig(t, s, plan), State: readResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Update: %v", updateResp.Diagnostics)
+	}
+	if _, ok := sent["is_hidden"]; ok {
+		t.Errorf("update sent the ignored is_hidden: %v", sent)
+	}
+	if sent["title"] != "Monthly Newsletter" {
+		t.Errorf("update sent title %v, want Monthly Newsletter", sent["title"])
+	}
+	if record["is_hidden"] != true {
+		t.Errorf("is_hidden was changed to %v", record["is_hidden"])
+	}
+	if !updateResp.State.Raw.Equal(planned.Raw) {
+		t.Errorf("Update produced an inconsistent result:\nplan:  %s\nstate: %s", planned.Raw, updateResp.State.Raw)
+	}
+}
+
+func TestContactTypeResourceIgnoreFields(t *testing.T) {
+	ctx := context.Background()
+
+	record := map[string]any{
+		"id": 9, "name": "Staff", "label": "Staff", "description": nil,
+		"image_URL": "https://example.org/staff.png", "icon": nil, "parent_id": nil,
+		"is_active": true, "is_reserved": false,
+	}
+	var sent map[string]any
+	client := newTestClient(t, func(req testRequest) ([]map[string]any, error) {
+		switch req.Entity + "." + req.Action {
+		case "ContactType.get":
+			return []map[string]any{record}, nil
+		case "ContactType.update":
+			sent, _ = req.Params["values"].(map[string]any)
+			maps.Copy(record, sent)
+			return []map[string]any{record}, nil
+		}
+		t.Errorf("unexpected request %s.%s", req.Entity, req.Action)
+		return nil, errors.New("unexpected request")
+	})
+
+	r := &ContactTypeResource{}
+	s := newTestResource(t, r, client)
+
+	ignoreFields, _ := types.ListValueFrom(ctx, types.StringType, []string{"image_url"})
+	state := testState(t, s, ContactTypeResourceModel{
+		ID:                   types.Int64Value(9),
+		Name:                 types.StringValue("Staff"),
+		Label:                types.StringValue("Staff"),
+		Description:          types.StringNull(),
+		ImageURL:             types.StringNull(),
+		Icon:                 types.StringNull(),
+		ParentID:             types.Int64Null(),
+		IsActive:             types.BoolValue(true),
+		IsReserved:           types.BoolValue(false),
+		AllowReservedChanges: types.BoolValue(false),
+		IgnoreFields:         ignoreFields,
+	})
+
+	readResp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read: %v", readResp.Diagnostics)
+	}
+	if !readResp.State.Raw.Equal(state.Raw) {
+		t.Errorf("Read reported drift of an ignored attribute:\nprior: %s\nread:  %s", state.Raw, readResp.State.Raw)
+	}
+
+	var plan ContactTypeResourceModel
+	readResp.State.Get(ctx, &plan)
+	plan.Label = types.StringValue("Staff Members")
+
+	planned := testPlan(t, s, plan)
+	updateResp := &resource.UpdateResponse{State: readResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: planned, Config: testConfig(t, s, plan), State: readResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Update: %v", updateResp.Diagnostics)
+	}
+	if _, ok := sent["image_URL"]; ok {
+		t.Errorf("update sent the ignored image_URL: %v", sent)
+	}
+	if !updateResp.State.Raw.Equal(planned.Raw) {
+		t.Errorf("Update produced an inconsistent result:\nplan:  %s\nstate: %s", planned.Raw, updateResp.State.Raw)
+	}
+}
+
+func TestOptionValueResourceIgnoreFields(t *testing.T) {
+	ctx := context.Background()
+
+	record := map[string]any{
+		"id": 81, "name": "retreat", "label": "Retreat", "value": "7",
+		"description": "Edited in CiviCRM", "weight": 7, "is_active": true, "filter": 0,
+	}
+	var sent map[string]any
+	client := newTestClient(t, func(req testRequest) ([]map[string]any, error) {
+		switch req.Entity + "." + req.Action {
+		case "OptionValue.get":
+			return []map[string]any{record}, nil
+		case "OptionValue.update":
+			sent, _ = req.Params["values"].(map[string]any)
+			maps.Copy(record, sent)
+			return []map[string]any{record}, nil
+		}
+		t.Errorf("unexpected request %s.%s", req.Entity, req.Action)
+		return nil, errors.New("unexpected request")
+	})
+
+	r := NewEventTypeResource().(*EventTypeResource)
+	s := newTestResource(t, r, client)
+
+	ignoreFields, _ := types.ListValueFrom(ctx, types.StringType, []string{"description"})
+	state := testState(t, s, optionValueModel{
+		ID:           types.Int64Value(81),
+		Name:         types.StringValue("retreat"),
+		Label:        types.StringValue("Retreat"),
+		Description:  types.StringNull(),
+		IsActive:     types.BoolValue(true),
+		Weight:       types.Int64Value(7),
+		Filter:       types.Int64Null(),
+		Value:        types.StringValue("7"),
+		IgnoreFields: ignoreFields,
+	})
+
+	readResp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read: %v", readResp.Diagnostics)
+	}
+	if !readResp.State.Raw.Equal(state.Raw) {
+		t.Errorf("Read reported drift of an ignored attribute:\nprior: %s\nread:  %s", state.Raw, readResp.State.Raw)
+	}
+
+	var plan optionValueModel
+	readResp.State.Get(ctx, &plan)
+	plan.Label = types.StringValue("Retreats")
+	config := plan
+	config.ID = types.Int64Null()
+	config.Weight = types.Int64Null()
+	config.Value = types.StringNull()
+
+	planned := testPlan(t, s, plan)
+	updateResp := &resource.UpdateResponse{State: readResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: planned, Config: testConfig(t, s, config), State: readResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Update: %v", updateResp.Diagnostics)
+	}
+	if _, ok := sent["description"]; ok {
+		t.Errorf("update sent the ignored description: %v", sent)
+	}
+	if record["description"] != "Edited in CiviCRM" {
+		t.Errorf("description was changed to %v", record["description"])
+	}
+	if !updateResp.State.Raw.Equal(planned.Raw) {
+		t.Errorf("Update produced an inconsistent result:\nplan:  %s\nstate: %s", planned.Raw, updateResp.State.Raw)
+	}
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// maxLoggedBodySize is the number of bytes of request and response bodies
+// written to the trace log.
+const maxLoggedBodySize = 4096
+
+// redactedValue replaces secrets in the trace log.
+const redactedValue = "***"
+
+// secretKeyPattern matches the names of parameters, fields and headers that
+// carry secrets: API and site keys, passwords, tokens and signatures. The
+// prefixes cover names such as access_token or X-WAF-Token of extra headers.
+const secretKeyPattern = `(?i:password|passwd|[a-z_-]*(?:key|secret|token|signature)|authorization|x-civi-auth)`
+
+var (
+	// secretJSONPattern matches string values of secret keys in JSON.
+	secretJSONPattern = regexp.MustCompile(`("` + secretKeyPattern + `"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	// secretFormPattern matches values of secret keys in query strings and
+	// form bodies.
+	secretFormPattern = regexp.MustCompile(`(^|[?&])(` + secretKeyPattern + `)=[^&]*`)
+	// secretKeyName matches exactly a secret key name.
+	secretKeyName = regexp.MustCompile(`^` + secretKeyPattern + `$`)
+)
+
+// redactSecrets replaces the values of secret keys in text, which can be
+// JSON or a query string.
+func redactSecrets(text string) string {
+	text = secretJSONPattern.ReplaceAllString(text, `$1"`+redactedValue+`"`)
+	return secretFormPattern.ReplaceAllString(text, `$1$2=`+redactedValue)
+}
+
+// truncateForLog shortens text to maxLoggedBodySize bytes.
+func truncateForLog(text string) string {
+	if len(text) <= maxLoggedBodySize {
+		return text
+	}
+	return text[:maxLoggedBodySize] + "... (truncated)"
+}
+
+// logExchange writes a request and its response to the trace log, with
+// secrets redacted, so that mapping problems can be diagnosed without a
+// proxy. The entity and action are taken from the fields of ctx, see
+// doRequest.
+func logExchange(ctx context.Context, req *http.Request, attempt int, duration time.Duration, statusCode int, body []byte, err error) {
+	fields := map[string]any{
+		"method":           req.Method,
+		"url":              redactSecrets(req.URL.String()),
+		"request_headers":  redactedHeaders(req.Header),
+		"request_body":     truncateForLog(redactSecrets(requestBodyForLog(req))),
+		"attempt":          attempt + 1,
+		"duration_ms":      duration.Milliseconds(),
+		"response_status":  statusCode,
+		"response_body":    truncateForLog(redactSecrets(string(body))),
+		"response_size":    len(body),
+		"response_success": err == nil && statusCode >= 200 && statusCode < 300,
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+	}
+
+	tflog.Trace(ctx, "CiviCRM API request", fields)
+}
+
+// redactedHeaders returns the request headers with the values of secret
+// headers replaced.
+func redactedHeaders(header http.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+	for name, values := range header {
+		value := strings.Join(values, ", ")
+		if secretKeyName.MatchString(name) {
+			value = redactedValue
+		}
+		headers[name] = value
+	}
+	return headers
+}
+
+// requestBodyForLog returns the body of req in a readable form. Form and
+// multipart bodies are decoded into a query string, so that the API
+// parameters they carry appear as JSON and can be redacted.
+func requestBodyForLog(req *http.Request) string {
+	if req.GetBody == nil {
+		return ""
+	}
+
+	reader, err := req.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer reader.Close()
+
+	raw, err := io.ReadAll(reader)
+	if err != nil {
+		return ""
+	}
+
+	mediaType, mediaParams, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(string(raw))
+		if err != nil {
+			return string(raw)
+		}
+		return formForLog(values)
+	case "multipart/form-data":
+		form, err := multipart.NewReader(bytes.NewReader(raw), mediaParams["boundary"]).ReadForm(int64(len(raw)))
+		if err != nil {
+			return string(raw)
+		}
+		defer form.RemoveAll()
+		return formForLog(form.Value)
+	}
+
+	return string(raw)
+}
+
+// formForLog joins form values into an unescaped query string, sorted by
+// name.
+func formForLog(values map[string][]string) string {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var parts []string
+	for _, name := range names {
+		for _, value := range values[name] {
+			parts = append(parts, name+"="+value)
+		}
+	}
+	return strings.Join(parts, "&")
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestRedactSecrets(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{
+			name: "JSON password",
+			text: `{"values":{"name":"Bounces","password":"hunter2","server":"imap.example.org"}}`,
+			want: `{"values":{"name":"Bounces","password":"***","server":"imap.example.org"}}`,
+		},
+		{
+			name: "JSON keys and tokens",
+			text: `{"api_key": "abc", "site_key":"def", "access_token":"ghi", "client_secret":"jkl"}`,
+			want: `{"api_key": "***", "site_key":"***", "access_token":"***", "client_secret":"***"}`,
+		},
+		{
+			name: "JSON escaped quote",
+			text: `{"password":"pa\"ss","name":"x"}`,
+			want: `{"password":"***","name":"x"}`,
+		},
+		{
+			name: "JSON numbers and names are kept",
+			text: `{"id":5,"name":"password","title":"API key"}`,
+			want: `{"id":5,"name":"password","title":"API key"}`,
+		},
+		{
+			name: "query string",
+			text: `https://example.org/civicrm/ajax/rest?entity=Attachment&api_key=abc&key=def&json=1`,
+			want: `https://example.org/civicrm/ajax/rest?entity=Attachment&api_key=***&key=***&json=1`,
+		},
+		{
+			name: "form body",
+			text: `password=hunter2&params={"where":[]}`,
+			want: `password=***&params={"where":[]}`,
+		},
+		{
+			name: "nothing to redact",
+			text: `{"version":4,"count":0,"values":[]}`,
+			want: `{"version":4,"count":0,"values":[]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redactSecrets(tt.text); got != tt.want {
+				t.Errorf("redactSecrets() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactedHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Set("Authorization", "Bearer abc")
+	header.Set("X-Civi-Auth", "Bearer abc")
+	header.Set("X-Civi-Key", "def")
+	header.Set("X-WAF-Token", "ghi")
+	header.Set("Content-Type", "application/x-www-form-urlencoded")
+	header.Set("X-Requested-With", "XMLHttpRequest")
+
+	got := redactedHeaders(header)
+
+	want := map[string]string{
+		"Authorization":    redactedValue,
+		"X-Civi-Auth":      redactedValue,
+		"X-Civi-Key":       redactedValue,
+		"X-Waf-Token":      redactedValue,
+		"Content-Type":     "application/x-www-form-urlencoded",
+		"X-Requested-With": "XMLHttpRequest",
+	}
+	for name, value := range want {
+		if got[name] != value {
+			t.Errorf("%s = %q, want %q", name, got[name], value)
+		}
+	}
+}
+
+func TestRequestBodyForLog(t *testing.T) {
+	form := url.Values{"params": {`{"values":{"name":"Bounces","password":"hunter2"}}`}}
+	formReq, err := http.NewRequest(http.MethodPost, "https://example.org/civicrm/ajax/api4/MailSettings/create", strings.NewReader(form.Encode()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	formReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	writer.WriteField("entity", "Attachment")
+	writer.WriteField("api_key", "abc")
+	writer.Close()
+	multipartReq, err := http.NewRequest(http.MethodPost, "https://example.org/civicrm/ajax/rest", bytes.NewReader(body.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	multipartReq.Header.Set("Content-Type", writer.FormDataContentType())
+
+	tests := []struct {
+		name string
+		req  *http.Request
+		want string
+	}{
+		{name: "form", req: formReq, want: `params={"values":{"name":"Bounces","password":"***"}}`},
+		{name: "multipart", req: multipartReq, want: `api_key=***&entity=Attachment`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redactSecrets(requestBodyForLog(tt.req)); got != tt.want {
+				t.Errorf("redacted body = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTruncateForLog(t *testing.T) {
+	short := strings.Repeat("a", maxLoggedBodySize)
+	if got := truncateForLog(short); got != short {
+		t.Errorf("truncateForLog() shortened a body of %d bytes", len(short))
+	}
+
+	long := strings.Repeat("a", maxLoggedBodySize+1)
+	if got := truncateForLog(long); got != short+"... (truncated)" {
+		t.Errorf("truncateForLog() = %d bytes, want %d bytes and a marker", len(got), maxLoggedBodySize)
+	}
+}
@@ -0,0 +1,311 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// optionValueModel holds the attributes shared by resources that are stored as
+// OptionValues of a single option group.
+type optionValueModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Label        types.String `tfsdk:"label"`
+	Description  types.String `tfsdk:"description"`
+	IsActive     types.Bool   `tfsdk:"is_active"`
+	Weight       types.Int64  `tfsdk:"weight"`
+	Filter       types.Int64  `tfsdk:"filter"`
+	Value        types.String `tfsdk:"value"`
+	IgnoreFields types.List   `tfsdk:"ignore_fields"`
+}
+
+// optionValueCRUD implements the API calls for a resource backed by the
+// OptionValues of one option group. noun is used in descriptions, e.g.
+// "group type". When configurableValue is set, the value can be configured
+// instead of being assigned by CiviCRM. valueDescription, if set, replaces
+// the description of the value attribute.
+type optionValueCRUD struct {
+	client            *Client
+	optionGroup       string
+	noun              string
+	configurableValue bool
+	valueDescription  string
+}
+
+// optionValueExtension manages the OptionValue fields of a resource that go
+// beyond optionValueModel, such as the icon and component of activity types.
+// Resources without such fields pass nil.
+type optionValueExtension interface {
+	// selectFields returns the fields to select in addition to
+	// optionValueModelSelect.
+	selectFields() []string
+	// buildValues adds the API values of the extension to values. On update,
+	// null values are sent so that they are cleared.
+	buildValues(values map[string]any, update bool)
+	// mapResponse maps the fields of the extension from result.
+	mapResponse(client *Client, result map[string]any)
+}
+
+// schemaAttributes returns the schema attributes matching optionValueModel.
+func (o *optionValueCRUD) schemaAttributes() map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: fmt.Sprintf("The unique identifier of the %s (OptionValue ID).", o.noun),
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: fmt.Sprintf("The machine name of the %s.", o.noun),
+			Required:    true,
+		},
+		"label": schema.StringAttribute{
+			Description: fmt.Sprintf("The display label of the %s.", o.noun),
+			Required:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: fmt.Sprintf("A description of the %s.", o.noun),
+			Optional:    true,
+		},
+		"is_active": schema.BoolAttribute{
+			Description: fmt.Sprintf("Whether the %s is active. Default: true.", o.noun),
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+		},
+		"weight": managedWeightAttribute(o.noun),
+		"filter": optionValueFilterAttribute(o.noun),
+		"value": schema.StringAttribute{
+			Description: o.describeValue(fmt.Sprintf("The value of the %s, which CiviCRM stores on referencing records.", o.noun)),
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ignore_fields": ignoreFieldsAttribute,
+	}
+
+	if o.configurableValue {
+		attributes["value"] = schema.StringAttribute{
+			Description: o.describeValue(fmt.Sprintf("The value of the %s, which CiviCRM stores on referencing records.", o.noun)) + " Assigned by CiviCRM when not set.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	return attributes
+}
+
+// describeValue returns the description of the value attribute.
+func (o *optionValueCRUD) describeValue(fallback string) string {
+	if o.valueDescription != "" {
+		return o.valueDescription
+	}
+	return fallback
+}
+
+// configuredOptionValueWeight returns the weight set in config, or nil when
+// CiviCRM assigns the weight, see configuredWeight.
+func configuredOptionValueWeight(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) *int64 {
+	if weight, ok := configuredWeight(ctx, config, diags); ok {
+		return &weight
+	}
+	return nil
+}
+
+// create creates the OptionValue for model and maps the response back into
+// model and extension. weight is the configured weight, see
+// configuredOptionValueWeight.
+func (o *optionValueCRUD) create(ctx context.Context, model *optionValueModel, weight *int64, extension optionValueExtension) error {
+	optionGroupID, err := o.client.GetOptionGroupID(ctx, o.optionGroup)
+	if err != nil {
+		return err
+	}
+
+	values := o.buildValues(model, weight, extension, false)
+	values["option_group_id"] = optionGroupID
+
+	result, err := o.client.Create(ctx, "OptionValue", values)
+	if err != nil {
+		return err
+	}
+
+	o.mapResponseToModel(result, model)
+	if extension == nil {
+		return nil
+	}
+
+	// The create response does not include pseudo fields such as
+	// component_id:name
+	return o.read(ctx, model, extension)
+}
+
+// optionValueModelSelect selects the OptionValue fields that
+// mapResponseToModel reads.
+var optionValueModelSelect = []string{
+	"id", "name", "label", "value", "description", "weight", "is_active", "filter",
+}
+
+// read refreshes model and extension from the OptionValue with the model's
+// ID.
+func (o *optionValueCRUD) read(ctx context.Context, model *optionValueModel, extension optionValueExtension) error {
+	fields := optionValueModelSelect
+	if extension != nil {
+		fields = append(slices.Clip(fields), extension.selectFields()...)
+	}
+
+	result, err := o.client.GetByID(ctx, "OptionValue", model.ID.ValueInt64(), fields)
+	if err != nil {
+		return err
+	}
+
+	o.mapResponseToModel(result, model)
+	if extension != nil {
+		extension.mapResponse(o.client, result)
+	}
+	return nil
+}
+
+// optionValueAPINames maps the attributes of optionValueModel and its
+// extensions that are sent under other API field names, see omitIgnoredFields.
+var optionValueAPINames = map[string][]string{
+	"component": {"component_id", "component_id:name"},
+}
+
+// update writes model and extension to the OptionValue with the given ID,
+// leaving out the ignored attributes, see ignoredFields. weight is the
+// configured weight, see configuredOptionValueWeight.
+func (o *optionValueCRUD) update(ctx context.Context, id int64, model *optionValueModel, weight *int64, extension optionValueExtension, ignored []string) error {
+	values := o.buildValues(model, weight, extension, true)
+	omitIgnoredFields(values, ignored, optionValueAPINames)
+
+	result, err := o.client.Update(ctx, "OptionValue", id, values)
+	if err != nil {
+		return err
+	}
+
+	model.ID = types.Int64Value(id)
+	o.mapResponseToModel(result, model)
+	if extension == nil {
+		return nil
+	}
+
+	return o.read(ctx, model, extension)
+}
+
+// delete removes the OptionValue with the given ID.
+func (o *optionValueCRUD) delete(ctx context.Context, id int64) error {
+	return o.client.Delete(ctx, "OptionValue", id)
+}
+
+// buildValues builds the API values for model and extension. The weight is
+// only sent when configured, so that CiviCRM can renumber the others. On
+// update, a null description is sent as nil so that it is cleared.
+func (o *optionValueCRUD) buildValues(model *optionValueModel, weight *int64, extension optionValueExtension, update bool) map[string]any {
+	values := map[string]any{
+		"name":      model.Name.ValueString(),
+		"label":     model.Label.ValueString(),
+		"is_active": model.IsActive.ValueBool(),
+	}
+
+	if !model.Description.IsNull() {
+		values["description"] = model.Description.ValueString()
+	} else if update {
+		values["description"] = nil
+	}
+
+	if weight != nil {
+		values["weight"] = *weight
+	}
+
+	if o.configurableValue && !model.Value.IsNull() && !model.Value.IsUnknown() {
+		values["value"] = model.Value.ValueString()
+	}
+
+	setOptionValueFilter(values, model.Filter, update)
+
+	if extension != nil {
+		extension.buildValues(values, update)
+	}
+
+	return values
+}
+
+// optionValueFilterAttribute returns the schema for the filter column of an
+// OptionValue.
+func optionValueFilterAttribute(noun string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description: fmt.Sprintf("The filter of the %s, which some option groups use to group or restrict their values. Leave unset for no filter.", noun),
+		Optional:    true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+}
+
+// setOptionValueFilter adds filter to values. On update, a null filter is sent
+// as 0 so that it is cleared.
+func setOptionValueFilter(values map[string]any, filter types.Int64, update bool) {
+	if !filter.IsNull() {
+		values["filter"] = filter.ValueInt64()
+	} else if update {
+		values["filter"] = 0
+	}
+}
+
+// optionValueFilter maps the filter of an OptionValue result, treating the
+// default 0 as null.
+func optionValueFilter(result map[string]any) types.Int64 {
+	if filter, ok := GetInt64(result, "filter"); ok && filter != 0 {
+		return types.Int64Value(filter)
+	}
+	return types.Int64Null()
+}
+
+func (o *optionValueCRUD) mapResponseToModel(result map[string]any, model *optionValueModel) {
+	if id, ok := GetInt64(result, "id"); ok {
+		model.ID = types.Int64Value(id)
+	}
+
+	if name, ok := GetString(result, "name"); ok {
+		model.Name = types.StringValue(name)
+	}
+
+	if label, ok := GetString(result, "label"); ok {
+		model.Label = types.StringValue(label)
+	}
+
+	model.Description = o.client.optionalString(result, "description", model.Description)
+
+	if active, ok := GetBool(result, "is_active"); ok {
+		model.IsActive = types.BoolValue(active)
+	}
+
+	if weight, ok := GetInt64(result, "weight"); ok {
+		model.Weight = types.Int64Value(weight)
+	} else if model.Weight.IsUnknown() {
+		model.Weight = types.Int64Null()
+	}
+
+	model.Filter = optionValueFilter(result)
+
+	if value, ok := GetString(result, "value"); ok {
+		model.Value = types.StringValue(value)
+	}
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOptionValueFilterRoundTrip(t *testing.T) {
+	tests := []struct {
+		name       string
+		filter     types.Int64
+		wantSent   any
+		wantStored any
+	}{
+		{name: "filter", filter: types.Int64Value(3), wantSent: float64(3), wantStored: float64(3)},
+		{name: "no filter", filter: types.Int64Null(), wantSent: nil, wantStored: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			record := map[string]any{
+				"id": 81, "name": "retreat", "label": "Retreat", "value": "7", "description": nil,
+				"weight": 7, "is_active": true, "filter": 0,
+			}
+			var sent map[string]any
+			client := newTestClient(t, func(req testRequest) ([]map[string]any, error) {
+				switch req.Entity + "." + req.Action {
+				case "OptionGroup.get":
+					return []map[string]any{{"id": 14}}, nil
+				case "OptionValue.create", "OptionValue.update":
+					sent, _ = req.Params["values"].(map[string]any)
+					maps.Copy(record, sent)
+					return []map[string]any{record}, nil
+				case "OptionValue.get":
+					return []map[string]any{record}, nil
+				}
+				t.Errorf("unexpected request %s.%s", req.Entity, req.Action)
+				return nil, errors.New("unexpected request")
+			})
+
+			r := NewEventTypeResource().(*EventTypeResource)
+			s := newTestResource(t, r, client)
+
+			plan := optionValueModel{
+				ID:           types.Int64Unknown(),
+				Name:         types.StringValue("retreat"),
+				Label:        types.StringValue("Retreat"),
+				Description:  types.StringNull(),
+				IsActive:     types.BoolValue(true),
+				Weight:       types.Int64Unknown(),
+				Filter:       tt.filter,
+				Value:        types.StringUnknown(),
+				IgnoreFields: types.ListNull(types.StringType),
+			}
+			config := plan
+			config.ID = types.Int64Null()
+			config.IsActive = types.BoolNull()
+			config.Weight = types.Int64Null()
+			config.Value = types.StringNull()
+
+			createResp := &resource.CreateResponse{State: emptyTestState(s)}
+			r.Create(ctx, resource.CreateRequest{Plan: testPlan(t, s, plan), Config: testConfig(t, s, config)}, createResp)
+			if createResp.Diagnostics.HasError() {
+				t.Fatalf("Create: %v", createResp.Diagnostics)
+			}
+			if sent["filter"] != tt.wantSent {
+				t.Errorf("create sent filter %v, want %v", sent["filter"], tt.wantSent)
+			}
+			if record["filter"] != tt.wantStored {
+				t.Errorf("stored filter %v, want %v", record["filter"], tt.wantStored)
+			}
+
+			readResp := &resource.ReadResponse{State: createResp.State}
+			r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+			if readResp.Diagnostics.HasError() {
+				t.Fatalf("Read: %v", readResp.Diagnostics)
+			}
+
+			var state optionValueModel
+			readResp.State.Get(ctx, &state)
+			if !state.Filter.Equal(tt.filter) {
+				t.Errorf("filter after Read = %s, want %s", state.Filter, tt.filter)
+			}
+			if !readResp.State.Raw.Equal(createResp.State.Raw) {
+				t.Errorf("Read changed the state:\ncreate: %s\nread:   %s", createResp.State.Raw, readResp.State.Raw)
+			}
+
+			// Removing the filter from the configuration resets it
+			plan = state
+			plan.Filter = types.Int64Null()
+			config.Filter = types.Int64Null()
+
+			updateResp := &resource.UpdateResponse{State: readResp.State}
+			r.Update(ctx, resource.UpdateRequest{Plan: testPlan(t, s, plan), Config: testConfig(t, s, config), State: readResp.State}, updateResp)
+			if updateResp.Diagnostics.HasError() {
+				t.Fatalf("Update: %v", updateResp.Diagnostics)
+			}
+			if sent["filter"] != float64(0) {
+				t.Errorf("update sent filter %v, want 0", sent["filter"])
+			}
+
+			updateResp.State.Get(ctx, &state)
+			if !state.Filter.IsNull() {
+				t.Errorf("filter after Update = %s, want null", state.Filter)
+			}
+		})
+	}
+}
@@ -3,12 +3,21 @@ package provider
 import (
 	"context"
 	"os"
+	"regexp"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -20,9 +29,37 @@ type CiviCRMProvider struct {
 }
 
 type CiviCRMProviderModel struct {
-	URL      types.String `tfsdk:"url"`
-	APIKey   types.String `tfsdk:"api_key"`
-	Insecure types.Bool   `tfsdk:"insecure"`
+	URL                types.String  `tfsdk:"url"`
+	APIKey             types.String  `tfsdk:"api_key"`
+	APIKeyHeader       types.String  `tfsdk:"api_key_header"`
+	SiteKey            types.String  `tfsdk:"site_key"`
+	AuthType           types.String  `tfsdk:"auth_type"`
+	Username           types.String  `tfsdk:"username"`
+	Password           types.String  `tfsdk:"password"`
+	JWT                types.String  `tfsdk:"jwt"`
+	JWTSigningKey      types.String  `tfsdk:"jwt_signing_key"`
+	JWTContactID       types.Int64   `tfsdk:"jwt_contact_id"`
+	JWTIssuer          types.String  `tfsdk:"jwt_issuer"`
+	JWTLifetime        types.String  `tfsdk:"jwt_lifetime"`
+	OAuth2TokenURL     types.String  `tfsdk:"oauth2_token_url"`
+	OAuth2ClientID     types.String  `tfsdk:"oauth2_client_id"`
+	OAuth2ClientSecret types.String  `tfsdk:"oauth2_client_secret"`
+	OAuth2Scopes       types.List    `tfsdk:"oauth2_scopes"`
+	Insecure           types.Bool    `tfsdk:"insecure"`
+	TreatEmptyAsNull   types.Bool    `tfsdk:"treat_empty_as_null"`
+	MaxRetries         types.Int64   `tfsdk:"max_retries"`
+	RetryWait          types.String  `tfsdk:"retry_wait"`
+	RequestTimeout     types.String  `tfsdk:"request_timeout"`
+	RequestsPerSecond  types.Float64 `tfsdk:"requests_per_second"`
+	CACertPEM          types.String  `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String  `tfsdk:"ca_cert_file"`
+	ClientCertPEM      types.String  `tfsdk:"client_cert_pem"`
+	ClientKeyPEM       types.String  `tfsdk:"client_key_pem"`
+	ProxyURL           types.String  `tfsdk:"proxy_url"`
+	APIVersion         types.String  `tfsdk:"api_version"`
+	EndpointStyle      types.String  `tfsdk:"endpoint_style"`
+	RESTPath           types.String  `tfsdk:"rest_path"`
+	ExtraHeaders       types.Map     `tfsdk:"extra_headers"`
 }
 
 func New(version string) func() provider.Provider {
@@ -53,10 +90,202 @@ func (p *CiviCRMProvider) Schema(ctx context.Context, req provider.SchemaRequest
 				Optional:  true,
 				Sensitive: true,
 			},
+			"api_key_header": schema.StringAttribute{
+				Description: "Name of the HTTP header used to send the API key (e.g., 'X-Civi-Auth' or 'X-Api-Key'). " +
+					"When set, the raw key is sent under this header instead of 'Authorization: Bearer <key>'. " +
+					"Can also be set via the CIVICRM_API_KEY_HEADER environment variable.",
+				Optional: true,
+			},
+			"api_version": schema.StringAttribute{
+				Description: "The CiviCRM API version to use: '4', or '3' for servers that only expose the API v3 REST endpoint. " +
+					"With '3', requests are translated to API v3, which only works for resources and data sources that use plain fields. Default: '4'.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("3", "4"),
+				},
+			},
+			"endpoint_style": schema.StringAttribute{
+				Description: "The CMS that serves CiviCRM, which determines the URL of the API endpoint: 'drupal', 'backdrop' and 'standalone' use clean URLs " +
+					"(<url>/civicrm/ajax/api4/...), 'wordpress' uses <url>/wp-admin/admin.php?page=CiviCRM&q=civicrm/ajax/api4/... and " +
+					"'joomla' uses <url>/administrator/index.php?option=com_civicrm&task=civicrm/ajax/api4/.... Conflicts with rest_path. Default: 'drupal'.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("drupal", "wordpress", "joomla", "backdrop", "standalone"),
+					stringvalidator.ConflictsWith(path.MatchRoot("rest_path")),
+				},
+			},
+			"rest_path": schema.StringAttribute{
+				Description: "The path between url and the CiviCRM route, for sites that none of the endpoint_style presets fit. " +
+					"For example, '/index.php?q=' sends API requests to <url>/index.php?q=civicrm/ajax/api4/.... Must start with '/'. Conflicts with endpoint_style.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/`), "must start with '/'"),
+				},
+			},
+			"extra_headers": schema.MapAttribute{
+				Description: "Additional HTTP headers to send with every API request, e.g. for a web application firewall in front of CiviCRM. " +
+					"They cannot replace the headers the provider authenticates with.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.RegexMatches(regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$"), "must be a valid HTTP header name"),
+					),
+				},
+			},
+			"site_key": schema.StringAttribute{
+				Description: "The site key of the CiviCRM instance (CIVICRM_SITE_KEY in civicrm.settings.php). When set, api_key and the site key are sent as the " +
+					"api_key and key parameters of the classic REST interface, for hosts that require them instead of AuthX bearer tokens. " +
+					"Only used when auth_type is 'api_key'. Conflicts with api_key_header. Can also be set via the CIVICRM_SITE_KEY environment variable.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("api_key_header")),
+				},
+			},
+			"auth_type": schema.StringAttribute{
+				Description: "How the provider authenticates: 'api_key' sends api_key, 'basic' sends username and password with the Basic scheme of AuthX, 'jwt' sends a JSON Web Token, 'oauth2' sends an access token of the OAuth2 client credentials grant. " +
+					"Can also be set via the CIVICRM_AUTH_TYPE environment variable. Default: 'api_key'.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(authTypeAPIKey, authTypeBasic, authTypeJWT, authTypeOAuth2),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "The CMS username to authenticate with when auth_type is 'basic'. " +
+					"Can also be set via the CIVICRM_USERNAME environment variable.",
+				Optional: true,
+			},
+			"password": schema.StringAttribute{
+				Description: "The CMS password to authenticate with when auth_type is 'basic'. " +
+					"Can also be set via the CIVICRM_PASSWORD environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"jwt": schema.StringAttribute{
+				Description: "A JSON Web Token issued by CiviCRM to send when auth_type is 'jwt'. The token is sent as is and not renewed. " +
+					"Conflicts with jwt_signing_key. Can also be set via the CIVICRM_JWT environment variable.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("jwt_signing_key")),
+				},
+			},
+			"jwt_signing_key": schema.StringAttribute{
+				Description: "The HS256 key to sign JSON Web Tokens with when auth_type is 'jwt'. It must be a signing key CiviCRM accepts for AuthX tokens. " +
+					"The provider mints a token for jwt_contact_id and replaces it before it expires. " +
+					"Can also be set via the CIVICRM_JWT_SIGNING_KEY environment variable.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("jwt_contact_id")),
+				},
+			},
+			"jwt_contact_id": schema.Int64Attribute{
+				Description: "The ID of the contact the minted JSON Web Tokens authenticate as. Requires jwt_signing_key.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AlsoRequires(path.MatchRoot("jwt_signing_key")),
+				},
+			},
+			"jwt_issuer": schema.StringAttribute{
+				Description: "The issuer (iss claim) of the minted JSON Web Tokens. Requires jwt_signing_key. Default: no issuer.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("jwt_signing_key")),
+				},
+			},
+			"jwt_lifetime": schema.StringAttribute{
+				Description: "How long a minted JSON Web Token is valid, as a duration (e.g., '10m', '1h'). Tokens are replaced once less than a fifth of their lifetime is left. " +
+					"Requires jwt_signing_key. Default: '5m'.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("jwt_signing_key")),
+				},
+			},
+			"oauth2_token_url": schema.StringAttribute{
+				Description: "The token endpoint to request access tokens from when auth_type is 'oauth2'. " +
+					"Can also be set via the CIVICRM_OAUTH2_TOKEN_URL environment variable.",
+				Optional: true,
+			},
+			"oauth2_client_id": schema.StringAttribute{
+				Description: "The client ID to request access tokens with when auth_type is 'oauth2'. " +
+					"Can also be set via the CIVICRM_OAUTH2_CLIENT_ID environment variable.",
+				Optional: true,
+			},
+			"oauth2_client_secret": schema.StringAttribute{
+				Description: "The client secret to request access tokens with when auth_type is 'oauth2'. " +
+					"Can also be set via the CIVICRM_OAUTH2_CLIENT_SECRET environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"oauth2_scopes": schema.ListAttribute{
+				Description: "The scopes to request access tokens for when auth_type is 'oauth2'. Default: no scope.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
 			"insecure": schema.BoolAttribute{
 				Description: "Skip TLS certificate verification. Only use for development. Default: false.",
 				Optional:    true,
 			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded CA certificates to trust in addition to the system CAs, for servers with a certificate of an internal CA. Conflicts with ca_cert_file.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file")),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a file with PEM encoded CA certificates to trust in addition to the system CAs. Conflicts with ca_cert_pem.",
+				Optional:    true,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded client certificate for servers that require TLS client authentication, e.g. at a reverse proxy. Requires client_key_pem.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key_pem")),
+				},
+			},
+			"client_key_pem": schema.StringAttribute{
+				Description: "PEM encoded private key of client_cert_pem. Requires client_cert_pem.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert_pem")),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of the proxy to send API requests through (e.g., 'http://proxy.example.org:3128'). " +
+					"When not set, the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables are used.",
+				Optional: true,
+			},
+			"treat_empty_as_null": schema.BoolAttribute{
+				Description: "Map empty optional strings returned by CiviCRM to null. When false, attributes explicitly set to \"\" keep that value instead of showing a diff. Default: true.",
+				Optional:    true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "How often a request is retried after a transient failure: a 429 response or a database deadlock, and for read requests also a connection error or a 502, 503 or 504 response. Requests that change data are only retried after connection errors when the connection could not be established. Set to 0 to disable retries. Default: 3.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait": schema.StringAttribute{
+				Description: "The wait before the first retry, as a duration (e.g., '500ms', '2s'). The wait doubles with every further retry, up to 30 seconds. Default: '1s'.",
+				Optional:    true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: "The maximum number of API requests per second, shared by all resources and data sources. Bursts of up to one second's worth of requests are sent at once. Set it for servers that throttle the parallel requests of terraform apply. Default: no limit.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "How long a single API request may take, as a duration (e.g., '90s', '5m'). Raise it for custom fields on large sites, where CiviCRM alters the database table. Each retry gets the full timeout. Default: '30s'.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -81,6 +310,30 @@ func (p *CiviCRMProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	for _, attr := range []struct {
+		name  string
+		value types.String
+	}{
+		{"site_key", config.SiteKey},
+		{"auth_type", config.AuthType},
+		{"username", config.Username},
+		{"password", config.Password},
+		{"jwt", config.JWT},
+		{"jwt_signing_key", config.JWTSigningKey},
+		{"oauth2_token_url", config.OAuth2TokenURL},
+		{"oauth2_client_id", config.OAuth2ClientID},
+		{"oauth2_client_secret", config.OAuth2ClientSecret},
+	} {
+		if attr.value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr.name),
+				"Unknown CiviCRM "+attr.name,
+				"The provider cannot create the CiviCRM API client as there is an unknown configuration value for "+attr.name+". "+
+					"Either set the value statically in the configuration, or use the CIVICRM_"+strings.ToUpper(attr.name)+" environment variable.",
+			)
+		}
+	}
+
 	if config.APIKey.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_key"),
@@ -97,6 +350,16 @@ func (p *CiviCRMProvider) Configure(ctx context.Context, req provider.ConfigureR
 	// Get values from environment variables if not set in config
 	url := os.Getenv("CIVICRM_URL")
 	apiKey := os.Getenv("CIVICRM_API_KEY")
+	apiKeyHeader := os.Getenv("CIVICRM_API_KEY_HEADER")
+	siteKey := os.Getenv("CIVICRM_SITE_KEY")
+	authType := os.Getenv("CIVICRM_AUTH_TYPE")
+	username := os.Getenv("CIVICRM_USERNAME")
+	password := os.Getenv("CIVICRM_PASSWORD")
+	jwt := os.Getenv("CIVICRM_JWT")
+	jwtSigningKey := os.Getenv("CIVICRM_JWT_SIGNING_KEY")
+	oauth2TokenURL := os.Getenv("CIVICRM_OAUTH2_TOKEN_URL")
+	oauth2ClientID := os.Getenv("CIVICRM_OAUTH2_CLIENT_ID")
+	oauth2ClientSecret := os.Getenv("CIVICRM_OAUTH2_CLIENT_SECRET")
 
 	if !config.URL.IsNull() {
 		url = config.URL.ValueString()
@@ -106,6 +369,54 @@ func (p *CiviCRMProvider) Configure(ctx context.Context, req provider.ConfigureR
 		apiKey = config.APIKey.ValueString()
 	}
 
+	if !config.APIKeyHeader.IsNull() {
+		apiKeyHeader = config.APIKeyHeader.ValueString()
+	}
+
+	if !config.SiteKey.IsNull() {
+		siteKey = config.SiteKey.ValueString()
+	}
+
+	if !config.AuthType.IsNull() {
+		authType = config.AuthType.ValueString()
+	}
+	if authType == "" {
+		authType = authTypeAPIKey
+	}
+
+	if !config.Username.IsNull() {
+		username = config.Username.ValueString()
+	}
+
+	if !config.Password.IsNull() {
+		password = config.Password.ValueString()
+	}
+
+	if !config.JWT.IsNull() {
+		jwt = config.JWT.ValueString()
+	}
+
+	if !config.JWTSigningKey.IsNull() {
+		jwtSigningKey = config.JWTSigningKey.ValueString()
+	}
+
+	if !config.OAuth2TokenURL.IsNull() {
+		oauth2TokenURL = config.OAuth2TokenURL.ValueString()
+	}
+
+	if !config.OAuth2ClientID.IsNull() {
+		oauth2ClientID = config.OAuth2ClientID.ValueString()
+	}
+
+	if !config.OAuth2ClientSecret.IsNull() {
+		oauth2ClientSecret = config.OAuth2ClientSecret.ValueString()
+	}
+
+	var oauth2Scopes []string
+	if !config.OAuth2Scopes.IsNull() {
+		resp.Diagnostics.Append(config.OAuth2Scopes.ElementsAs(ctx, &oauth2Scopes, false)...)
+	}
+
 	// Validate required values
 	if url == "" {
 		resp.Diagnostics.AddAttributeError(
@@ -116,12 +427,80 @@ func (p *CiviCRMProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
-	if apiKey == "" {
+	switch authType {
+	case authTypeAPIKey:
+		if apiKey == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_key"),
+				"Missing CiviCRM API Key",
+				"The provider cannot create the CiviCRM API client as there is no API key configured. "+
+					"Either set the api_key attribute in the provider configuration, or use the CIVICRM_API_KEY environment variable.",
+			)
+		}
+	case authTypeBasic:
+		if username == "" || password == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("username"),
+				"Missing CiviCRM Credentials",
+				"The provider cannot create the CiviCRM API client as auth_type is 'basic' but no username or password is configured. "+
+					"Either set the username and password attributes in the provider configuration, or use the CIVICRM_USERNAME and CIVICRM_PASSWORD environment variables.",
+			)
+		}
+	case authTypeJWT:
+		switch {
+		case jwt == "" && jwtSigningKey == "":
+			resp.Diagnostics.AddAttributeError(
+				path.Root("jwt"),
+				"Missing CiviCRM JSON Web Token",
+				"The provider cannot create the CiviCRM API client as auth_type is 'jwt' but neither a token nor a signing key is configured. "+
+					"Either set the jwt or jwt_signing_key attribute in the provider configuration, or use the CIVICRM_JWT or CIVICRM_JWT_SIGNING_KEY environment variable.",
+			)
+		case jwt != "" && jwtSigningKey != "":
+			resp.Diagnostics.AddAttributeError(
+				path.Root("jwt"),
+				"Conflicting CiviCRM JSON Web Token Settings",
+				"The provider cannot create the CiviCRM API client as both a token and a signing key are configured. Configure only one of jwt and jwt_signing_key.",
+			)
+		case jwtSigningKey != "" && config.JWTContactID.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root("jwt_contact_id"),
+				"Missing CiviCRM JSON Web Token Contact",
+				"The provider cannot mint JSON Web Tokens without the contact to authenticate as. Set the jwt_contact_id attribute in the provider configuration.",
+			)
+		}
+	case authTypeOAuth2:
+		if oauth2TokenURL == "" || oauth2ClientID == "" || oauth2ClientSecret == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("oauth2_token_url"),
+				"Missing CiviCRM OAuth2 Client Credentials",
+				"The provider cannot create the CiviCRM API client as auth_type is 'oauth2' but the token URL, client ID or client secret is not configured. "+
+					"Either set the oauth2_token_url, oauth2_client_id and oauth2_client_secret attributes in the provider configuration, "+
+					"or use the CIVICRM_OAUTH2_TOKEN_URL, CIVICRM_OAUTH2_CLIENT_ID and CIVICRM_OAUTH2_CLIENT_SECRET environment variables.",
+			)
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auth_type"),
+			"Invalid CiviCRM Authentication Type",
+			"Unsupported authentication type '"+authType+"' from CIVICRM_AUTH_TYPE. Expected 'api_key', 'basic', 'jwt' or 'oauth2'.",
+		)
+	}
+
+	// The schema validator only sees the configuration, not the environment
+	if siteKey != "" && apiKeyHeader != "" {
 		resp.Diagnostics.AddAttributeError(
-			path.Root("api_key"),
-			"Missing CiviCRM API Key",
-			"The provider cannot create the CiviCRM API client as there is no API key configured. "+
-				"Either set the api_key attribute in the provider configuration, or use the CIVICRM_API_KEY environment variable.",
+			path.Root("site_key"),
+			"Conflicting CiviCRM API Key Settings",
+			"The provider cannot create the CiviCRM API client as both a site key and an API key header are configured. "+
+				"Configure only one of site_key and api_key_header, including the CIVICRM_SITE_KEY and CIVICRM_API_KEY_HEADER environment variables.",
+		)
+	}
+
+	if siteKey != "" && authType != authTypeAPIKey {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("site_key"),
+			"Unused CiviCRM Site Key",
+			"The site key is only sent with API keys and is ignored as auth_type is '"+authType+"'.",
 		)
 	}
 
@@ -135,13 +514,36 @@ func (p *CiviCRMProvider) Configure(ctx context.Context, req provider.ConfigureR
 		insecure = config.Insecure.ValueBool()
 	}
 
+	retryWait := durationAttribute(config.RetryWait, defaultRetryWait, "retry_wait", &resp.Diagnostics)
+	requestTimeout := durationAttribute(config.RequestTimeout, defaultRequestTimeout, "request_timeout", &resp.Diagnostics)
+	jwtLifetime := durationAttribute(config.JWTLifetime, defaultJWTLifetime, "jwt_lifetime", &resp.Diagnostics)
+	if jwtLifetime == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("jwt_lifetime"),
+			"Invalid duration",
+			"jwt_lifetime must be longer than zero.",
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "Creating CiviCRM API client", map[string]any{
-		"url":      url,
-		"insecure": insecure,
+		"url":                 url,
+		"api_key_header":      apiKeyHeader,
+		"auth_type":           authType,
+		"insecure":            insecure,
+		"api_version":         config.APIVersion.ValueString(),
+		"endpoint_style":      config.EndpointStyle.ValueString(),
+		"rest_path":           config.RESTPath.ValueString(),
+		"max_retries":         config.MaxRetries.ValueInt64(),
+		"retry_wait":          retryWait.String(),
+		"request_timeout":     requestTimeout.String(),
+		"requests_per_second": config.RequestsPerSecond.ValueFloat64(),
 	})
 
 	// Create the API client
-	client, err := NewClient(url, apiKey, insecure)
+	client, err := NewClient(url, apiKey, apiKeyHeader, insecure)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create CiviCRM API client",
@@ -151,6 +553,115 @@ func (p *CiviCRMProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
+	caCert := []byte(config.CACertPEM.ValueString())
+	caCertAttribute := "ca_cert_pem"
+	if !config.CACertFile.IsNull() {
+		caCert, err = os.ReadFile(config.CACertFile.ValueString())
+		caCertAttribute = "ca_cert_file"
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_cert_file"),
+				"Unable to read CA certificate file",
+				"Could not read "+config.CACertFile.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+	if len(caCert) > 0 {
+		if err := client.addCACertificates(caCert); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(caCertAttribute),
+				"Invalid CA certificate",
+				"Could not load the CA certificates: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if !config.ClientCertPEM.IsNull() {
+		err := client.setClientCertificate([]byte(config.ClientCertPEM.ValueString()), []byte(config.ClientKeyPEM.ValueString()))
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("client_cert_pem"),
+				"Invalid client certificate",
+				"Could not load the client certificate and key: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if !config.ProxyURL.IsNull() {
+		if err := client.setProxy(config.ProxyURL.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("proxy_url"),
+				"Invalid proxy URL",
+				"Could not use the proxy: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	switch authType {
+	case authTypeAPIKey:
+		if siteKey != "" {
+			client.auth = apiKeyAuth{key: apiKey, siteKey: siteKey}
+		}
+	case authTypeBasic:
+		client.auth = basicAuth{username: username, password: password}
+	case authTypeJWT:
+		auth := &jwtAuth{token: jwt}
+		if jwtSigningKey != "" {
+			auth.signingKey = []byte(jwtSigningKey)
+			auth.contactID = config.JWTContactID.ValueInt64()
+			auth.issuer = config.JWTIssuer.ValueString()
+			auth.lifetime = jwtLifetime
+		}
+		client.auth = auth
+	case authTypeOAuth2:
+		client.auth = &oauth2Auth{
+			tokenURL:     oauth2TokenURL,
+			clientID:     oauth2ClientID,
+			clientSecret: oauth2ClientSecret,
+			scopes:       oauth2Scopes,
+			httpClient:   client.httpClient,
+		}
+	}
+
+	client.userAgent = userAgentName + "/" + p.version
+
+	if !config.ExtraHeaders.IsNull() {
+		resp.Diagnostics.Append(config.ExtraHeaders.ElementsAs(ctx, &client.extraHeaders, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !config.EndpointStyle.IsNull() {
+		client.restPath = endpointStyles[config.EndpointStyle.ValueString()]
+	}
+
+	if !config.RESTPath.IsNull() {
+		client.restPath = config.RESTPath.ValueString()
+	}
+
+	if config.APIVersion.ValueString() == "3" {
+		client.apiVersion = 3
+	}
+
+	if !config.TreatEmptyAsNull.IsNull() {
+		client.treatEmptyAsNull = config.TreatEmptyAsNull.ValueBool()
+	}
+
+	if !config.MaxRetries.IsNull() {
+		client.maxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	client.retryWait = retryWait
+	client.requestTimeout = requestTimeout
+
+	if rps := config.RequestsPerSecond.ValueFloat64(); rps > 0 {
+		client.limiter = newRateLimiter(rps)
+	}
+
 	// Make the client available to resources and data sources
 	resp.DataSourceData = client
 	resp.ResourceData = client
@@ -160,6 +671,26 @@ func (p *CiviCRMProvider) Configure(ctx context.Context, req provider.ConfigureR
 	})
 }
 
+// durationAttribute parses an optional duration attribute of the provider
+// configuration, returning def when it is not set.
+func durationAttribute(value types.String, def time.Duration, name string, diags *diag.Diagnostics) time.Duration {
+	if value.IsNull() {
+		return def
+	}
+
+	d, err := time.ParseDuration(value.ValueString())
+	if err != nil || d < 0 {
+		diags.AddAttributeError(
+			path.Root(name),
+			"Invalid duration",
+			name+" must be a non-negative duration such as '500ms', '2s' or '5m', got: "+value.ValueString(),
+		)
+		return def
+	}
+
+	return d
+}
+
 func (p *CiviCRMProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewGroupResource,
@@ -173,6 +704,26 @@ func (p *CiviCRMProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewTagResource,
 		NewContactTypeResource,
 		NewRelationshipTypeResource,
+		NewDashboardContactResource,
+		NewACLAssignmentResource,
+		NewAttachmentResource,
+		NewGroupTypeResource,
+		NewCampaignGroupResource,
+		NewCustomSchemaResource,
+		NewEmailResource,
+		NewOptionValueResource,
+		NewEntityFinancialAccountResource,
+		NewPaymentProcessorResource,
+		NewSettingResource,
+		NewSearchDisplayResource,
+		NewAfformResource,
+		NewLocationTypeResource,
+		NewExtensionResource,
+		NewPriceFieldResource,
+		NewDedupeRuleGroupResource,
+		NewDedupeRuleResource,
+		NewActivityTypeResource,
+		NewEventTypeResource,
 	}
 }
 
@@ -182,5 +733,25 @@ func (p *CiviCRMProvider) DataSources(ctx context.Context) []func() datasource.D
 		NewACLRoleDataSource,
 		NewACLDataSource,
 		NewACLEntityRoleDataSource,
+		NewMessageTemplateDataSource,
+		NewACLCheckDataSource,
+		NewSystemCheckDataSource,
+		NewGroupChildrenDataSource,
+		NewACLRoleRulesDataSource,
+		NewACLHealthDataSource,
+		NewACLAuditDataSource,
+		NewCustomFieldDataSource,
+		NewGroupsDataSource,
+		NewContactDataSource,
+		NewOptionValuesDataSource,
+		NewExtensionsDataSource,
+		NewSystemInfoDataSource,
+		NewEntityFieldsDataSource,
+		NewTagsDataSource,
+		NewACLRolesDataSource,
+		NewLocationTypeDataSource,
+		NewJobDataSource,
+		NewGroupContactCountDataSource,
+		NewCustomFieldsDataSource,
 	}
 }
@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testRequest is an API v4 request received by the test server.
+type testRequest struct {
+	Entity string
+	Action string
+	Params map[string]any
+	Header http.Header
+}
+
+// testHandler answers an API v4 request with the values of the response, or
+// with an error that is returned as a CiviCRM error message.
+type testHandler func(req testRequest) ([]map[string]any, error)
+
+// newTestServer starts a server that passes API v4 requests to handle. It is
+// closed when the test ends.
+func newTestServer(t *testing.T, handle testHandler) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		entity, action, ok := strings.Cut(strings.TrimPrefix(r.URL.Path, "/civicrm/ajax/api4/"), "/")
+		if !ok {
+			t.Errorf("unexpected request path %s", r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+
+		params := map[string]any{}
+		if err := json.Unmarshal([]byte(r.FormValue("params")), &params); err != nil {
+			t.Errorf("%s.%s: invalid params: %v", entity, action, err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		values, err := handle(testRequest{Entity: entity, Action: action, Params: params, Header: r.Header})
+
+		w.Header().Set("Content-Type", "application/json")
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_ = json.NewEncoder(w).Encode(map[string]any{"error_code": 0, "error_message": err.Error()})
+			return
+		}
+		if values == nil {
+			values = []map[string]any{}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"version": 4, "count": len(values), "values": values})
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// newTestClient returns a client for a test server that passes API v4
+// requests to handle. Retries are disabled, so that errors are returned at
+// once.
+func newTestClient(t *testing.T, handle testHandler) *Client {
+	t.Helper()
+
+	client, err := NewClient(newTestServer(t, handle).URL, "secret", "", false)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.maxRetries = 0
+
+	return client
+}
+
+// newTestResource returns the schema of r after configuring it with client.
+func newTestResource(t *testing.T, r resource.ResourceWithConfigure, client *Client) schema.Schema {
+	t.Helper()
+
+	ctx := context.Background()
+
+	configureResp := &resource.ConfigureResponse{}
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: client}, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("Configure: %v", configureResp.Diagnostics)
+	}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("Schema: %v", schemaResp.Diagnostics)
+	}
+
+	return schemaResp.Schema
+}
+
+// hasAttributeDiagnostic reports whether diags holds a diagnostic of severity
+// for the root attribute name.
+func hasAttributeDiagnostic(diags diag.Diagnostics, severity diag.Severity, name string) bool {
+	for _, d := range diags {
+		withPath, ok := d.(diag.DiagnosticWithPath)
+		if ok && d.Severity() == severity && withPath.Path().Equal(path.Root(name)) {
+			return true
+		}
+	}
+	return false
+}
+
+// emptyTestState returns a state of s without a resource, as passed to
+// Create.
+func emptyTestState(s schema.Schema) tfsdk.State {
+	return tfsdk.State{
+		Schema: s,
+		Raw:    tftypes.NewValue(s.Type().TerraformType(context.Background()), nil),
+	}
+}
+
+// testState returns a state of s that holds model.
+func testState(t *testing.T, s schema.Schema, model any) tfsdk.State {
+	t.Helper()
+
+	state := emptyTestState(s)
+	if diags := state.Set(context.Background(), model); diags.HasError() {
+		t.Fatalf("failed to set state: %v", diags)
+	}
+	return state
+}
+
+// testPlan returns a plan of s that holds model.
+func testPlan(t *testing.T, s schema.Schema, model any) tfsdk.Plan {
+	t.Helper()
+
+	return tfsdk.Plan{Schema: s, Raw: testState(t, s, model).Raw}
+}
+
+// testConfig returns a configuration of s that holds model.
+func testConfig(t *testing.T, s schema.Schema, model any) tfsdk.Config {
+	t.Helper()
+
+	return tfsdk.Config{Schema: s, Raw: testState(t, s, model).Raw}
+}
+
+// newTestDataSource returns the schema of d after configuring it with client.
+func newTestDataSource(t *testing.T, d datasource.DataSourceWithConfigure, client *Client) dsschema.Schema {
+	t.Helper()
+
+	ctx := context.Background()
+
+	configureResp := &datasource.ConfigureResponse{}
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: client}, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("Configure: %v", configureResp.Diagnostics)
+	}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("Schema: %v", schemaResp.Diagnostics)
+	}
+
+	return schemaResp.Schema
+}
+
+// emptyTestDataSourceState returns a state of the data source schema s
+// without values, as passed to Read.
+func emptyTestDataSourceState(s dsschema.Schema) tfsdk.State {
+	return tfsdk.State{
+		Schema: s,
+		Raw:    tftypes.NewValue(s.Type().TerraformType(context.Background()), nil),
+	}
+}
+
+// testDataSourceConfig returns a configuration of the data source schema s
+// that holds model.
+func testDataSourceConfig(t *testing.T, s dsschema.Schema, model any) tfsdk.Config {
+	t.Helper()
+
+	state := emptyTestDataSourceState(s)
+	if diags := state.Set(context.Background(), model); diags.HasError() {
+		t.Fatalf("failed to set config: %v", diags)
+	}
+	return tfsdk.Config{Schema: s, Raw: state.Raw}
+}
+
+func TestProviderConfigureSiteKeyConflict(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  map[string]string
+		env     map[string]string
+		wantErr bool
+	}{
+		{name: "site key", config: map[string]string{"site_key": "site"}},
+		{name: "api key header", config: map[string]string{"api_key_header": "X-Civi-Auth"}},
+		{name: "both configured", config: map[string]string{"site_key": "site", "api_key_header": "X-Civi-Auth"}, wantErr: true},
+		{name: "header from environment", config: map[string]string{"site_key": "site"}, env: map[string]string{"CIVICRM_API_KEY_HEADER": "X-Civi-Auth"}, wantErr: true},
+		{name: "site key from environment", config: map[string]string{"api_key_header": "X-Civi-Auth"}, env: map[string]string{"CIVICRM_SITE_KEY": "site"}, wantErr: true},
+		{name: "both from environment", env: map[string]string{"CIVICRM_SITE_KEY": "site", "CIVICRM_API_KEY_HEADER": "X-Civi-Auth"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			for _, name := range []string{"CIVICRM_SITE_KEY", "CIVICRM_API_KEY_HEADER", "CIVICRM_AUTH_TYPE"} {
+				t.Setenv(name, tt.env[name])
+			}
+
+			p := &CiviCRMProvider{version: "test"}
+			schemaResp := &provider.SchemaResponse{}
+			p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+
+			state := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			values := map[string]string{"url": "https://civicrm.example.org", "api_key": "secret"}
+			maps.Copy(values, tt.config)
+			for name, value := range values {
+				if diags := state.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+					t.Fatalf("SetAttribute(%s): %v", name, diags)
+				}
+			}
+
+			resp := &provider.ConfigureResponse{}
+			p.Configure(ctx, provider.ConfigureRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}, resp)
+			if got := hasAttributeDiagnostic(resp.Diagnostics, diag.SeverityError, "site_key"); got != tt.wantErr {
+				t.Errorf("site_key error = %t, want %t: %v", got, tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// allowReservedChangesAttribute is the schema for the flag that unlocks changes
+// to entities CiviCRM reports as reserved.
+var allowReservedChangesAttribute = schema.BoolAttribute{
+	Description: "Allow updating or deleting the entity while CiviCRM reports it as reserved (is_reserved = true). Default: false.",
+	Optional:    true,
+	Computed:    true,
+	Default:     booldefault.StaticBool(false),
+}
+
+// checkReservedChange rejects plans that update or delete an entity whose
+// prior state has is_reserved = true, unless allow_reserved_changes is set.
+// Reserved entities are managed by CiviCRM itself and changing them can break
+// the installation. It must run after any other plan modification.
+func checkReservedChange(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, noun string) {
+	// Nothing to protect on create
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var reserved types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("is_reserved"), &reserved)...)
+	if resp.Diagnostics.HasError() || !reserved.ValueBool() {
+		return
+	}
+
+	var allow types.Bool
+	if req.Plan.Raw.IsNull() {
+		// On destroy only the prior state is available
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("allow_reserved_changes"), &allow)...)
+		if resp.Diagnostics.HasError() || allow.ValueBool() {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Cannot delete reserved "+noun,
+			"This "+noun+" is reserved by CiviCRM. Set allow_reserved_changes = true and apply before destroying it.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("allow_reserved_changes"), &allow)...)
+	if resp.Diagnostics.HasError() || allow.ValueBool() || resp.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Cannot change reserved "+noun,
+		"This "+noun+" is reserved by CiviCRM. Set allow_reserved_changes = true to update it anyway.",
+	)
+}
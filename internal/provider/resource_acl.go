@@ -2,9 +2,15 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -12,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -20,8 +27,12 @@ var (
 	_ resource.Resource                = &ACLResource{}
 	_ resource.ResourceWithConfigure   = &ACLResource{}
 	_ resource.ResourceWithImportState = &ACLResource{}
+	_ resource.ResourceWithModifyPlan  = &ACLResource{}
 )
 
+// aclOperations lists the operations an ACL rule can grant.
+var aclOperations = []string{"Edit", "View", "Create", "Delete", "Search", "All"}
+
 // ACLResource manages ACL rules in CiviCRM.
 // ACL rules define what operations a role can perform on specific data.
 type ACLResource struct {
@@ -29,18 +40,26 @@ type ACLResource struct {
 }
 
 type ACLResourceModel struct {
-	ID          types.Int64  `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Deny        types.Bool   `tfsdk:"deny"`
-	EntityTable types.String `tfsdk:"entity_table"`
-	EntityID    types.Int64  `tfsdk:"entity_id"`
-	Operation   types.String `tfsdk:"operation"`
-	ObjectTable types.String `tfsdk:"object_table"`
-	ObjectID    types.Int64  `tfsdk:"object_id"`
-	AclTable    types.String `tfsdk:"acl_table"`
-	AclID       types.Int64  `tfsdk:"acl_id"`
-	IsActive    types.Bool   `tfsdk:"is_active"`
-	Priority    types.Int64  `tfsdk:"priority"`
+	ID           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Deny         types.Bool   `tfsdk:"deny"`
+	EntityTable  types.String `tfsdk:"entity_table"`
+	EntityID     types.Int64  `tfsdk:"entity_id"`
+	Operation    types.String `tfsdk:"operation"`
+	Operations   types.List   `tfsdk:"operations"`
+	OperationIDs types.Map    `tfsdk:"operation_ids"`
+	ObjectTable  types.String `tfsdk:"object_table"`
+	ObjectID     types.Int64  `tfsdk:"object_id"`
+	AclTable     types.String `tfsdk:"acl_table"`
+	AclID        types.Int64  `tfsdk:"acl_id"`
+	IsActive     types.Bool   `tfsdk:"is_active"`
+	Priority     types.Int64  `tfsdk:"priority"`
+}
+
+// aclSelect selects the ACL fields that mapResponseToModel reads.
+var aclSelect = []string{
+	"id", "name", "entity_table", "entity_id", "operation", "object_table", "object_id",
+	"acl_table", "acl_id", "deny", "priority", "is_active",
 }
 
 func NewACLResource() resource.Resource {
@@ -56,7 +75,7 @@ func (r *ACLResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 		Description: "Manages a CiviCRM ACL rule. ACL rules define what operations a role can perform on specific data.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
-				Description: "The unique identifier of the ACL.",
+				Description: "The unique identifier of the ACL. With operations, the ID of the rule for the first operation.",
 				Computed:    true,
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
@@ -77,8 +96,27 @@ func (r *ACLResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 				Required:    true,
 			},
 			"operation": schema.StringAttribute{
-				Description: "The operation this ACL grants. Options: 'Edit', 'View', 'Create', 'Delete', 'Search', 'All'.",
-				Required:    true,
+				Description: "The operation this ACL grants. Options: 'Edit', 'View', 'Create', 'Delete', 'Search', 'All'. Specify either operation or operations.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(aclOperations...),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("operations")),
+				},
+			},
+			"operations": schema.ListAttribute{
+				Description: "Several operations to grant with the same settings. One ACL rule is created per operation and the rules are managed as a unit. Specify either operation or operations.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(aclOperations...)),
+				},
+			},
+			"operation_ids": schema.MapAttribute{
+				Description: "The IDs of the ACL rules created for operations, keyed by operation. Null when operation is used.",
+				ElementType: types.Int64Type,
+				Computed:    true,
 			},
 			"object_table": schema.StringAttribute{
 				Description: "The type of object being permissioned (e.g., 'civicrm_group', 'civicrm_saved_search', 'civicrm_uf_group').",
@@ -134,110 +172,94 @@ func (r *ACLResource) Configure(ctx context.Context, req resource.ConfigureReque
 	r.client = client
 }
 
-func (r *ACLResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan ACLResourceModel
-	diags := req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+// ModifyPlan plans the rule IDs when operations is used. They stay the same as
+// long as the set of operations does not change.
+func (r *ACLResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
 		return
 	}
 
-	tflog.Debug(ctx, "Creating ACL", map[string]any{
-		"name":      plan.Name.ValueString(),
-		"operation": plan.Operation.ValueString(),
-	})
-
-	// Build values for API call
-	values := map[string]any{
-		"name":         plan.Name.ValueString(),
-		"entity_table": plan.EntityTable.ValueString(),
-		"entity_id":    plan.EntityID.ValueInt64(),
-		"operation":    plan.Operation.ValueString(),
-		"object_table": plan.ObjectTable.ValueString(),
-		"is_active":    plan.IsActive.ValueBool(),
-		"deny":         plan.Deny.ValueBool(),
-	}
-
-	if !plan.ObjectID.IsNull() {
-		values["object_id"] = plan.ObjectID.ValueInt64()
-	}
-
-	if !plan.AclTable.IsNull() {
-		values["acl_table"] = plan.AclTable.ValueString()
-	}
-
-	if !plan.AclID.IsNull() {
-		values["acl_id"] = plan.AclID.ValueInt64()
-	}
-
-	if !plan.Priority.IsNull() {
-		values["priority"] = plan.Priority.ValueInt64()
-	}
-
-	// Call API
-	result, err := r.client.Create("ACL", values)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating ACL",
-			"Could not create ACL, unexpected error: "+err.Error(),
-		)
+	var plan, state ACLResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Update state with response
-	if id, ok := GetInt64(result, "id"); ok {
-		plan.ID = types.Int64Value(id)
-	}
-
-	if name, ok := GetString(result, "name"); ok {
-		plan.Name = types.StringValue(name)
-	}
-
-	if entityTable, ok := GetString(result, "entity_table"); ok {
-		plan.EntityTable = types.StringValue(entityTable)
-	}
-
-	if entityID, ok := GetInt64(result, "entity_id"); ok {
-		plan.EntityID = types.Int64Value(entityID)
+	// A single rule keeps its ID when its operation changes
+	if plan.Operations.IsNull() && state.OperationIDs.IsNull() {
+		return
 	}
 
-	if operation, ok := GetString(result, "operation"); ok {
-		plan.Operation = types.StringValue(operation)
+	operationIDs := types.MapNull(types.Int64Type)
+	if !plan.Operations.IsNull() {
+		operationIDs = types.MapUnknown(types.Int64Type)
 	}
+	id := types.Int64Unknown()
 
-	if objectTable, ok := GetString(result, "object_table"); ok {
-		plan.ObjectTable = types.StringValue(objectTable)
-	}
+	if !plan.Operations.IsUnknown() && !plan.Operation.IsUnknown() {
+		operations := aclModelOperations(ctx, plan, &resp.Diagnostics)
+		rows := aclStateRows(ctx, state, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 
-	if objectID, ok := GetInt64(result, "object_id"); ok {
-		plan.ObjectID = types.Int64Value(objectID)
-	} else {
-		plan.ObjectID = types.Int64Null()
+		if aclSameOperations(operations, rows) {
+			id = types.Int64Value(rows[operations[0]])
+			if !plan.Operations.IsNull() {
+				var d diag.Diagnostics
+				operationIDs, d = types.MapValueFrom(ctx, types.Int64Type, rows)
+				resp.Diagnostics.Append(d...)
+			}
+		}
 	}
 
-	if aclTable, ok := GetString(result, "acl_table"); ok && aclTable != "" {
-		plan.AclTable = types.StringValue(aclTable)
-	} else {
-		plan.AclTable = types.StringNull()
-	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("operation_ids"), operationIDs)...)
+}
 
-	if aclID, ok := GetInt64(result, "acl_id"); ok {
-		plan.AclID = types.Int64Value(aclID)
-	} else {
-		plan.AclID = types.Int64Null()
+func (r *ACLResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ACLResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if active, ok := GetBool(result, "is_active"); ok {
-		plan.IsActive = types.BoolValue(active)
+	operations := aclModelOperations(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if deny, ok := GetBool(result, "deny"); ok {
-		plan.Deny = types.BoolValue(deny)
-	}
+	tflog.Debug(ctx, "Creating ACL", map[string]any{
+		"name":       plan.Name.ValueString(),
+		"operations": operations,
+	})
 
-	if priority, ok := GetInt64(result, "priority"); ok {
-		plan.Priority = types.Int64Value(priority)
-	}
+	rows := make(map[string]int64, len(operations))
+	var primary map[string]any
+	for _, operation := range operations {
+		result, err := r.client.Create(ctx, "ACL", r.buildValues(plan, operation, false))
+		if err != nil {
+			// Do not leave some of the rules behind
+			r.rollbackRows(ctx, rows)
+			resp.Diagnostics.AddError(
+				"Error creating ACL",
+				"Could not create ACL, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		id, _ := GetInt64(result, "id")
+		rows[operation] = id
+		if primary == nil {
+			primary = result
+		}
+	}
+
+	r.mapResponseToModel(primary, &plan)
+	r.setRows(ctx, operations, rows, &plan, &resp.Diagnostics)
 
 	tflog.Debug(ctx, "Created ACL", map[string]any{
 		"id": plan.ID.ValueInt64(),
@@ -259,95 +281,299 @@ func (r *ACLResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		"id": state.ID.ValueInt64(),
 	})
 
-	result, err := r.client.GetByID("ACL", state.ID.ValueInt64(), nil)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading ACL",
-			"Could not read ACL ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
-		)
+	if state.OperationIDs.IsNull() {
+		result, err := r.client.GetByID(ctx, "ACL", state.ID.ValueInt64(), aclSelect)
+		if errors.Is(err, ErrNotFound) {
+			tflog.Warn(ctx, "ACL no longer exists, removing from state", map[string]any{
+				"id": state.ID.ValueInt64(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading ACL",
+				"Could not read ACL ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+			)
+			return
+		}
+
+		r.mapResponseToModel(result, &state)
+
+		diags = resp.State.Set(ctx, state)
+		resp.Diagnostics.Append(diags...)
 		return
 	}
 
-	// Update state
-	if name, ok := GetString(result, "name"); ok {
-		state.Name = types.StringValue(name)
+	operations := aclModelOperations(ctx, state, &resp.Diagnostics)
+	rows := aclStateRows(ctx, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if entityTable, ok := GetString(result, "entity_table"); ok {
-		state.EntityTable = types.StringValue(entityTable)
+	ids := make([]int64, 0, len(rows))
+	for _, operation := range operations {
+		ids = append(ids, rows[operation])
 	}
 
-	if entityID, ok := GetInt64(result, "entity_id"); ok {
-		state.EntityID = types.Int64Value(entityID)
+	results, err := r.client.Get(ctx, "ACL", [][]any{
+		{"id", "IN", ids},
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ACL",
+			"Could not read ACL IDs "+aclFormatIDs(ids)+": "+err.Error(),
+		)
+		return
 	}
 
-	if operation, ok := GetString(result, "operation"); ok {
-		state.Operation = types.StringValue(operation)
+	byID := make(map[int64]map[string]any, len(results))
+	for _, result := range results {
+		if id, ok := GetInt64(result, "id"); ok {
+			byID[id] = result
+		}
+	}
+
+	// Rebuild the operations from the rules that still exist, so that rules
+	// deleted or changed outside of Terraform show up as a diff
+	found := make([]string, 0, len(ids))
+	foundRows := make(map[string]int64, len(ids))
+	var primary map[string]any
+	for _, id := range ids {
+		result, ok := byID[id]
+		if !ok {
+			continue
+		}
+		operation, _ := GetString(result, "operation")
+		if _, ok := foundRows[operation]; ok {
+			continue
+		}
+		found = append(found, operation)
+		foundRows[operation] = id
+		if primary == nil {
+			primary = result
+		}
+	}
+
+	if primary == nil {
+		tflog.Warn(ctx, "ACL rules no longer exist, removing from state", map[string]any{
+			"ids": aclFormatIDs(ids),
+		})
+		resp.State.RemoveResource(ctx)
+		return
 	}
 
-	if objectTable, ok := GetString(result, "object_table"); ok {
-		state.ObjectTable = types.StringValue(objectTable)
-	}
+	r.mapResponseToModel(primary, &state)
+	r.setRows(ctx, found, foundRows, &state, &resp.Diagnostics)
 
-	if objectID, ok := GetInt64(result, "object_id"); ok {
-		state.ObjectID = types.Int64Value(objectID)
-	} else {
-		state.ObjectID = types.Int64Null()
-	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
 
-	if aclTable, ok := GetString(result, "acl_table"); ok && aclTable != "" {
-		state.AclTable = types.StringValue(aclTable)
-	} else {
-		state.AclTable = types.StringNull()
+func (r *ACLResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ACLResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if aclID, ok := GetInt64(result, "acl_id"); ok {
-		state.AclID = types.Int64Value(aclID)
-	} else {
-		state.AclID = types.Int64Null()
+	var state ACLResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if active, ok := GetBool(result, "is_active"); ok {
-		state.IsActive = types.BoolValue(active)
+	operations := aclModelOperations(ctx, plan, &resp.Diagnostics)
+	current := aclStateRows(ctx, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if deny, ok := GetBool(result, "deny"); ok {
-		state.Deny = types.BoolValue(deny)
-	}
+	tflog.Debug(ctx, "Updating ACL", map[string]any{
+		"id":         state.ID.ValueInt64(),
+		"operations": operations,
+	})
 
-	if priority, ok := GetInt64(result, "priority"); ok {
-		state.Priority = types.Int64Value(priority)
-	}
+	// Rules of operations that were removed are reused for added operations
+	// before new rules are created, which keeps a single rule's ID stable
+	var free []int64
+	for _, operation := range aclSortedOperations(current) {
+		if !slices.Contains(operations, operation) {
+			free = append(free, current[operation])
+		}
+	}
+
+	// created holds the rules created by this update, which are removed again
+	// when a later call fails so that no grant is left outside of the state
+	rows := make(map[string]int64, len(operations))
+	created := map[string]int64{}
+	var primary map[string]any
+	for _, operation := range operations {
+		id, ok := current[operation]
+		if !ok && len(free) > 0 {
+			id, free, ok = free[0], free[1:], true
+		}
+
+		var result map[string]any
+		var err error
+		if ok {
+			result, err = r.client.Update(ctx, "ACL", id, r.buildValues(plan, operation, true))
+		} else {
+			result, err = r.client.Create(ctx, "ACL", r.buildValues(plan, operation, false))
+		}
+		if err != nil {
+			r.rollbackRows(ctx, created)
+			resp.Diagnostics.AddError(
+				"Error updating ACL",
+				"Could not update ACL ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+			)
+			return
+		}
+
+		if !ok {
+			id, _ = GetInt64(result, "id")
+			created[operation] = id
+		}
+		rows[operation] = id
+		if primary == nil {
+			primary = result
+		}
+	}
+
+	// Rules of removed operations are only left over when no rule was
+	// created. The applied rules are still recorded in state when deleting
+	// them fails, so that the next apply does not create them again.
+	for _, id := range free {
+		if err := r.client.Delete(ctx, "ACL", id); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating ACL",
+				"Could not delete ACL ID "+strconv.FormatInt(id, 10)+" of a removed operation, it must be removed manually: "+err.Error(),
+			)
+		}
+	}
+
+	r.mapResponseToModel(primary, &plan)
+	r.setRows(ctx, operations, rows, &plan, &resp.Diagnostics)
 
-	diags = resp.State.Set(ctx, state)
+	tflog.Debug(ctx, "Updated ACL", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
 
-func (r *ACLResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan ACLResourceModel
-	diags := req.Plan.Get(ctx, &plan)
+func (r *ACLResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ACLResourceModel
+	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var state ACLResourceModel
-	diags = req.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
+	rows := aclStateRows(ctx, state, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	tflog.Debug(ctx, "Updating ACL", map[string]any{
+	tflog.Debug(ctx, "Deleting ACL", map[string]any{
 		"id": state.ID.ValueInt64(),
 	})
 
-	// Build values for API call
+	for _, operation := range aclSortedOperations(rows) {
+		id := rows[operation]
+		if err := r.client.Delete(ctx, "ACL", id); err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting ACL",
+				"Could not delete ACL ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Deleted ACL", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+}
+
+// ImportState accepts a single ACL ID, or a comma-separated list of the IDs of
+// rules that differ only in their operation to import them as operations.
+func (r *ACLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ",")
+	ids := make([]int64, 0, len(parts))
+	for _, part := range parts {
+		id, err := strconv.ParseInt(strings.TrimSpace(part), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid import ID",
+				"Could not parse import ID as integer: "+err.Error(),
+			)
+			return
+		}
+		ids = append(ids, id)
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ids[0])...)
+	if len(ids) == 1 {
+		return
+	}
+
+	results, err := r.client.Get(ctx, "ACL", [][]any{
+		{"id", "IN", ids},
+	}, []string{"id", "operation"})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing ACL",
+			"Could not read ACL IDs "+aclFormatIDs(ids)+": "+err.Error(),
+		)
+		return
+	}
+
+	byID := make(map[int64]string, len(results))
+	for _, result := range results {
+		id, _ := GetInt64(result, "id")
+		operation, _ := GetString(result, "operation")
+		byID[id] = operation
+	}
+
+	operations := make([]string, 0, len(ids))
+	rows := make(map[string]int64, len(ids))
+	for _, id := range ids {
+		operation, ok := byID[id]
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Error importing ACL",
+				"ACL ID "+strconv.FormatInt(id, 10)+" not found",
+			)
+			return
+		}
+		if _, ok := rows[operation]; ok {
+			resp.Diagnostics.AddError(
+				"Error importing ACL",
+				"More than one of the ACL IDs has the operation "+operation,
+			)
+			return
+		}
+		operations = append(operations, operation)
+		rows[operation] = id
+	}
+
+	var model ACLResourceModel
+	r.setRows(ctx, operations, rows, &model, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("operations"), model.Operations)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("operation_ids"), model.OperationIDs)...)
+}
+
+// buildValues builds the API values of the rule for one operation. On update,
+// cleared optional attributes are sent as nil.
+func (r *ACLResource) buildValues(plan ACLResourceModel, operation string, update bool) map[string]any {
 	values := map[string]any{
 		"name":         plan.Name.ValueString(),
 		"entity_table": plan.EntityTable.ValueString(),
 		"entity_id":    plan.EntityID.ValueInt64(),
-		"operation":    plan.Operation.ValueString(),
+		"operation":    operation,
 		"object_table": plan.ObjectTable.ValueString(),
 		"is_active":    plan.IsActive.ValueBool(),
 		"deny":         plan.Deny.ValueBool(),
@@ -355,132 +581,177 @@ func (r *ACLResource) Update(ctx context.Context, req resource.UpdateRequest, re
 
 	if !plan.ObjectID.IsNull() {
 		values["object_id"] = plan.ObjectID.ValueInt64()
-	} else {
+	} else if update {
 		values["object_id"] = nil
 	}
 
 	if !plan.AclTable.IsNull() {
 		values["acl_table"] = plan.AclTable.ValueString()
-	} else {
+	} else if update {
 		values["acl_table"] = nil
 	}
 
 	if !plan.AclID.IsNull() {
 		values["acl_id"] = plan.AclID.ValueInt64()
-	} else {
+	} else if update {
 		values["acl_id"] = nil
 	}
 
-	if !plan.Priority.IsNull() {
+	if !plan.Priority.IsNull() && !plan.Priority.IsUnknown() {
 		values["priority"] = plan.Priority.ValueInt64()
 	}
 
-	// Call API
-	result, err := r.client.Update("ACL", state.ID.ValueInt64(), values)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating ACL",
-			"Could not update ACL ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
-		)
+	return values
+}
+
+// rollbackRows removes the rules created before a later create or update of
+// the same resource failed.
+func (r *ACLResource) rollbackRows(ctx context.Context, rows map[string]int64) {
+	for _, id := range rows {
+		if err := r.client.Delete(ctx, "ACL", id); err != nil {
+			tflog.Warn(ctx, "Could not remove ACL after failed apply", map[string]any{
+				"id":    id,
+				"error": err.Error(),
+			})
+		}
+	}
+}
+
+// setRows stores the rule IDs in model. With a single operation, model keeps
+// using operation unless it already uses operations.
+func (r *ACLResource) setRows(ctx context.Context, operations []string, rows map[string]int64, model *ACLResourceModel, diags *diag.Diagnostics) {
+	model.ID = types.Int64Value(rows[operations[0]])
+
+	if model.Operations.IsNull() {
+		model.OperationIDs = types.MapNull(types.Int64Type)
 		return
 	}
 
-	// Update state
-	plan.ID = state.ID
+	list, d := types.ListValueFrom(ctx, types.StringType, operations)
+	diags.Append(d...)
+	model.Operations = list
 
+	ids, d := types.MapValueFrom(ctx, types.Int64Type, rows)
+	diags.Append(d...)
+	model.OperationIDs = ids
+	model.Operation = types.StringNull()
+}
+
+func (r *ACLResource) mapResponseToModel(result map[string]any, model *ACLResourceModel) {
 	if name, ok := GetString(result, "name"); ok {
-		plan.Name = types.StringValue(name)
+		model.Name = types.StringValue(name)
 	}
 
 	if entityTable, ok := GetString(result, "entity_table"); ok {
-		plan.EntityTable = types.StringValue(entityTable)
+		model.EntityTable = types.StringValue(entityTable)
 	}
 
 	if entityID, ok := GetInt64(result, "entity_id"); ok {
-		plan.EntityID = types.Int64Value(entityID)
+		model.EntityID = types.Int64Value(entityID)
 	}
 
-	if operation, ok := GetString(result, "operation"); ok {
-		plan.Operation = types.StringValue(operation)
+	if operation, ok := GetString(result, "operation"); ok && model.Operations.IsNull() {
+		model.Operation = types.StringValue(operation)
 	}
 
 	if objectTable, ok := GetString(result, "object_table"); ok {
-		plan.ObjectTable = types.StringValue(objectTable)
+		model.ObjectTable = types.StringValue(objectTable)
 	}
 
-	if objectID, ok := GetInt64(result, "object_id"); ok {
-		plan.ObjectID = types.Int64Value(objectID)
-	} else {
-		plan.ObjectID = types.Int64Null()
-	}
+	model.ObjectID = aclObjectIDValue(result, model.ObjectID)
 
-	if aclTable, ok := GetString(result, "acl_table"); ok && aclTable != "" {
-		plan.AclTable = types.StringValue(aclTable)
-	} else {
-		plan.AclTable = types.StringNull()
-	}
+	model.AclTable = r.client.optionalString(result, "acl_table", model.AclTable)
 
 	if aclID, ok := GetInt64(result, "acl_id"); ok {
-		plan.AclID = types.Int64Value(aclID)
+		model.AclID = types.Int64Value(aclID)
 	} else {
-		plan.AclID = types.Int64Null()
+		model.AclID = types.Int64Null()
 	}
 
 	if active, ok := GetBool(result, "is_active"); ok {
-		plan.IsActive = types.BoolValue(active)
+		model.IsActive = types.BoolValue(active)
 	}
 
 	if deny, ok := GetBool(result, "deny"); ok {
-		plan.Deny = types.BoolValue(deny)
+		model.Deny = types.BoolValue(deny)
 	}
 
 	if priority, ok := GetInt64(result, "priority"); ok {
-		plan.Priority = types.Int64Value(priority)
+		model.Priority = types.Int64Value(priority)
 	}
+}
 
-	tflog.Debug(ctx, "Updated ACL", map[string]any{
-		"id": plan.ID.ValueInt64(),
-	})
+// aclModelOperations returns the operations of model, from either operation or
+// operations.
+func aclModelOperations(ctx context.Context, model ACLResourceModel, diags *diag.Diagnostics) []string {
+	if model.Operations.IsNull() {
+		return []string{model.Operation.ValueString()}
+	}
 
-	diags = resp.State.Set(ctx, plan)
-	resp.Diagnostics.Append(diags...)
+	var operations []string
+	diags.Append(model.Operations.ElementsAs(ctx, &operations, false)...)
+	return operations
 }
 
-func (r *ACLResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state ACLResourceModel
-	diags := req.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+// aclStateRows returns the rule IDs of state keyed by operation.
+func aclStateRows(ctx context.Context, state ACLResourceModel, diags *diag.Diagnostics) map[string]int64 {
+	if state.OperationIDs.IsNull() || state.OperationIDs.IsUnknown() {
+		return map[string]int64{state.Operation.ValueString(): state.ID.ValueInt64()}
 	}
 
-	tflog.Debug(ctx, "Deleting ACL", map[string]any{
-		"id": state.ID.ValueInt64(),
-	})
+	rows := map[string]int64{}
+	diags.Append(state.OperationIDs.ElementsAs(ctx, &rows, false)...)
+	return rows
+}
 
-	err := r.client.Delete("ACL", state.ID.ValueInt64())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error deleting ACL",
-			"Could not delete ACL ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
-		)
-		return
+// aclSameOperations reports whether operations covers exactly the operations
+// that have a rule in rows.
+func aclSameOperations(operations []string, rows map[string]int64) bool {
+	if len(operations) != len(rows) {
+		return false
 	}
+	for _, operation := range operations {
+		if _, ok := rows[operation]; !ok {
+			return false
+		}
+	}
+	return true
+}
 
-	tflog.Debug(ctx, "Deleted ACL", map[string]any{
-		"id": state.ID.ValueInt64(),
-	})
+// aclSortedOperations returns the operations of rows in a stable order.
+func aclSortedOperations(rows map[string]int64) []string {
+	operations := make([]string, 0, len(rows))
+	for operation := range rows {
+		operations = append(operations, operation)
+	}
+	slices.Sort(operations)
+	return operations
 }
 
-func (r *ACLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id, err := strconv.ParseInt(req.ID, 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid import ID",
-			"Could not parse import ID as integer: "+err.Error(),
-		)
-		return
+func aclFormatIDs(ids []int64) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.FormatInt(id, 10)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// aclObjectIDValue maps the object_id of an ACL result. CiviCRM may store
+// "all objects" as either null or 0; both are kept in the form the
+// configuration uses so that neither shows up as drift.
+func aclObjectIDValue(result map[string]any, current types.Int64) types.Int64 {
+	objectID, ok := GetInt64(result, "object_id")
+	if !ok || objectID == 0 {
+		if current.IsNull() || current.IsUnknown() {
+			return types.Int64Null()
+		}
+		if current.ValueInt64() == 0 {
+			return current
+		}
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	if !ok {
+		return types.Int64Null()
+	}
+	return types.Int64Value(objectID)
 }
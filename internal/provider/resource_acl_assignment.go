@@ -0,0 +1,488 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &ACLAssignmentResource{}
+	_ resource.ResourceWithConfigure   = &ACLAssignmentResource{}
+	_ resource.ResourceWithImportState = &ACLAssignmentResource{}
+)
+
+// ACLAssignmentResource creates an ACL role and assigns it to a group in one
+// step. It composes what civicrm_acl_role and civicrm_acl_entity_role manage
+// separately.
+type ACLAssignmentResource struct {
+	client *Client
+}
+
+type ACLAssignmentResourceModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	RoleName        types.String `tfsdk:"role_name"`
+	RoleLabel       types.String `tfsdk:"role_label"`
+	RoleDescription types.String `tfsdk:"role_description"`
+	RoleValue       types.String `tfsdk:"role_value"`
+	GroupID         types.Int64  `tfsdk:"group_id"`
+	EntityRoleID    types.Int64  `tfsdk:"entity_role_id"`
+	IsActive        types.Bool   `tfsdk:"is_active"`
+}
+
+func NewACLAssignmentResource() resource.Resource {
+	return &ACLAssignmentResource{}
+}
+
+func (r *ACLAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl_assignment"
+}
+
+func (r *ACLAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a CiviCRM ACL role and assigns it to a group. This is a shortcut for a civicrm_acl_role together with a civicrm_acl_entity_role.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier of the ACL role (OptionValue ID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_name": schema.StringAttribute{
+				Description: "The machine name of the ACL role.",
+				Required:    true,
+			},
+			"role_label": schema.StringAttribute{
+				Description: "The display label of the ACL role.",
+				Required:    true,
+			},
+			"role_description": schema.StringAttribute{
+				Description: "A description of the ACL role.",
+				Optional:    true,
+			},
+			"role_value": schema.StringAttribute{
+				Description: "The option value of the ACL role, which ACL rules reference as entity_id.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.Int64Attribute{
+				Description: "The ID of the group whose members receive the role.",
+				Required:    true,
+			},
+			"entity_role_id": schema.Int64Attribute{
+				Description: "The unique identifier of the ACL entity role assignment.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_active": schema.BoolAttribute{
+				Description: "Whether the role and its assignment are active. Read as false when either of them is disabled in CiviCRM. Default: true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *ACLAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ACLAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ACLAssignmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating ACL assignment", map[string]any{
+		"role_name": plan.RoleName.ValueString(),
+		"group_id":  plan.GroupID.ValueInt64(),
+	})
+
+	// Look up the acl_role option group ID
+	optionGroupID, err := r.client.GetOptionGroupID(ctx, "acl_role")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error looking up option group",
+			"Could not find acl_role option group: "+err.Error(),
+		)
+		return
+	}
+
+	roleValues := map[string]any{
+		"option_group_id": optionGroupID,
+		"name":            plan.RoleName.ValueString(),
+		"label":           plan.RoleLabel.ValueString(),
+		"is_active":       plan.IsActive.ValueBool(),
+	}
+
+	if !plan.RoleDescription.IsNull() {
+		roleValues["description"] = plan.RoleDescription.ValueString()
+	}
+
+	// Roles that already have the name are kept on rollback
+	existingRoles, err := r.roleIDsByName(ctx, optionGroupID, plan.RoleName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ACL assignment",
+			"Could not look up existing ACL roles: "+err.Error(),
+		)
+		return
+	}
+
+	// The entity role is chained to the role, so that both are created in
+	// one request
+	role, err := r.client.CreateWithChain(ctx, "OptionValue", roleValues, map[string]APIChain{
+		"entity_role": {
+			Entity: "ACLEntityRole",
+			Action: "create",
+			Params: map[string]any{
+				"values": map[string]any{
+					"acl_role_id":  "$value",
+					"entity_table": "civicrm_group",
+					"entity_id":    plan.GroupID.ValueInt64(),
+					"is_active":    plan.IsActive.ValueBool(),
+				},
+			},
+		},
+	})
+	if err != nil {
+		// The role is created even when the chained call fails. Do not leave
+		// a half-created assignment behind
+		r.rollbackNewRoles(ctx, optionGroupID, plan.RoleName.ValueString(), existingRoles)
+		resp.Diagnostics.AddError(
+			"Error creating ACL assignment",
+			"Could not create ACL role and assign it to group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	r.mapRoleToModel(role, &plan)
+
+	entityRoles := ChainResults(role, "entity_role")
+	if len(entityRoles) == 0 {
+		r.rollbackRole(ctx, plan.ID.ValueInt64())
+		resp.Diagnostics.AddError(
+			"Error creating ACL assignment",
+			"No ACL entity role was returned for the assignment of the ACL role to the group.",
+		)
+		return
+	}
+	r.mapEntityRoleToModel(entityRoles[0], &plan)
+
+	tflog.Debug(ctx, "Created ACL assignment", map[string]any{
+		"id":             plan.ID.ValueInt64(),
+		"entity_role_id": plan.EntityRoleID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ACLAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ACLAssignmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading ACL assignment", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	role, err := r.client.GetByID(ctx, "OptionValue", state.ID.ValueInt64(), aclRoleSelect)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "ACL assignment no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ACL assignment",
+			"Could not read ACL role ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+	r.mapRoleToModel(role, &state)
+
+	entityRole, err := r.client.GetByID(ctx, "ACLEntityRole", state.EntityRoleID.ValueInt64(), []string{"id", "entity_id", "is_active"})
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "ACL assignment no longer exists, removing from state", map[string]any{
+			"id":             state.ID.ValueInt64(),
+			"entity_role_id": state.EntityRoleID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ACL assignment",
+			"Could not read ACL entity role ID "+strconv.FormatInt(state.EntityRoleID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+	r.mapEntityRoleToModel(entityRole, &state)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ACLAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ACLAssignmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ACLAssignmentResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating ACL assignment", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	roleValues := map[string]any{
+		"name":      plan.RoleName.ValueString(),
+		"label":     plan.RoleLabel.ValueString(),
+		"is_active": plan.IsActive.ValueBool(),
+	}
+
+	if !plan.RoleDescription.IsNull() {
+		roleValues["description"] = plan.RoleDescription.ValueString()
+	} else {
+		roleValues["description"] = nil
+	}
+
+	role, err := r.client.Update(ctx, "OptionValue", state.ID.ValueInt64(), roleValues)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ACL assignment",
+			"Could not update ACL role ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	entityRole, err := r.client.Update(ctx, "ACLEntityRole", state.EntityRoleID.ValueInt64(), map[string]any{
+		"entity_id": plan.GroupID.ValueInt64(),
+		"is_active": plan.IsActive.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ACL assignment",
+			"Could not update ACL entity role ID "+strconv.FormatInt(state.EntityRoleID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	plan.ID = state.ID
+	plan.EntityRoleID = state.EntityRoleID
+	r.mapRoleToModel(role, &plan)
+	r.mapEntityRoleToModel(entityRole, &plan)
+
+	tflog.Debug(ctx, "Updated ACL assignment", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ACLAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ACLAssignmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting ACL assignment", map[string]any{
+		"id":             state.ID.ValueInt64(),
+		"entity_role_id": state.EntityRoleID.ValueInt64(),
+	})
+
+	// Remove the assignment before the role it references
+	err := r.client.Delete(ctx, "ACLEntityRole", state.EntityRoleID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting ACL assignment",
+			"Could not delete ACL entity role ID "+strconv.FormatInt(state.EntityRoleID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	err = r.client.Delete(ctx, "OptionValue", state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting ACL assignment",
+			"Could not delete ACL role ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted ACL assignment", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+}
+
+// ImportState accepts an import ID of the form "acl_role_id/entity_role_id".
+func (r *ACLAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Expected import ID in the format 'acl_role_id/entity_role_id', got: "+req.ID,
+		)
+		return
+	}
+
+	id, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Could not parse acl_role_id as integer: "+err.Error(),
+		)
+		return
+	}
+
+	entityRoleID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Could not parse entity_role_id as integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("entity_role_id"), entityRoleID)...)
+}
+
+// rollbackRole removes an ACL role whose assignment could not be created.
+func (r *ACLAssignmentResource) rollbackRole(ctx context.Context, id int64) {
+	if err := r.client.Delete(ctx, "OptionValue", id); err != nil {
+		tflog.Warn(ctx, "Could not remove ACL role after failed assignment", map[string]any{
+			"id":    id,
+			"error": err.Error(),
+		})
+	}
+}
+
+// roleIDsByName returns the IDs of the ACL roles with the given name.
+func (r *ACLAssignmentResource) roleIDsByName(ctx context.Context, optionGroupID int64, name string) ([]int64, error) {
+	roles, err := r.client.Get(ctx, "OptionValue", [][]any{
+		{"option_group_id", "=", optionGroupID},
+		{"name", "=", name},
+	}, []string{"id"})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(roles))
+	for _, role := range roles {
+		if id, ok := GetInt64(role, "id"); ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// rollbackNewRoles removes the ACL roles with the given name that are not in
+// existing, for failures that do not return the ID of the created role.
+func (r *ACLAssignmentResource) rollbackNewRoles(ctx context.Context, optionGroupID int64, name string, existing []int64) {
+	ids, err := r.roleIDsByName(ctx, optionGroupID, name)
+	if err != nil {
+		tflog.Warn(ctx, "Could not look up ACL role after failed assignment", map[string]any{
+			"name":  name,
+			"error": err.Error(),
+		})
+		return
+	}
+
+	for _, id := range ids {
+		if !slices.Contains(existing, id) {
+			r.rollbackRole(ctx, id)
+		}
+	}
+}
+
+func (r *ACLAssignmentResource) mapRoleToModel(result map[string]any, model *ACLAssignmentResourceModel) {
+	if id, ok := GetInt64(result, "id"); ok {
+		model.ID = types.Int64Value(id)
+	}
+
+	if name, ok := GetString(result, "name"); ok {
+		model.RoleName = types.StringValue(name)
+	}
+
+	if label, ok := GetString(result, "label"); ok {
+		model.RoleLabel = types.StringValue(label)
+	}
+
+	model.RoleDescription = r.client.optionalString(result, "description", model.RoleDescription)
+
+	if value, ok := GetString(result, "value"); ok {
+		model.RoleValue = types.StringValue(value)
+	}
+
+	if active, ok := GetBool(result, "is_active"); ok {
+		model.IsActive = types.BoolValue(active)
+	}
+}
+
+// mapEntityRoleToModel maps the assignment after mapRoleToModel has mapped the
+// role. The assignment is only active when both the role and the entity role
+// are, so that disabling either of them in CiviCRM shows up as drift.
+func (r *ACLAssignmentResource) mapEntityRoleToModel(result map[string]any, model *ACLAssignmentResourceModel) {
+	if id, ok := GetInt64(result, "id"); ok {
+		model.EntityRoleID = types.Int64Value(id)
+	}
+
+	if entityID, ok := GetInt64(result, "entity_id"); ok {
+		model.GroupID = types.Int64Value(entityID)
+	}
+
+	if active, ok := GetBool(result, "is_active"); ok {
+		model.IsActive = types.BoolValue(model.IsActive.ValueBool() && active)
+	}
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestACLAssignmentResourceIsActiveChangedOutside(t *testing.T) {
+	tests := []struct {
+		name             string
+		roleActive       bool
+		entityRoleActive bool
+		wantActive       bool
+	}{
+		{name: "both active", roleActive: true, entityRoleActive: true, wantActive: true},
+		{name: "entity role disabled", roleActive: true, entityRoleActive: false},
+		{name: "role disabled", roleActive: false, entityRoleActive: true},
+		{name: "both disabled", roleActive: false, entityRoleActive: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			role := map[string]any{
+				"id": 15, "name": "volunteer_coordinator", "label": "Volunteer Coordinator",
+				"value": "5", "description": nil, "is_active": tt.roleActive,
+			}
+			entityRole := map[string]any{
+				"id": 12, "acl_role_id": 5, "entity_table": "civicrm_group", "entity_id": 8,
+				"is_active": tt.entityRoleActive,
+			}
+			sent := map[string]map[string]any{}
+			client := newTestClient(t, func(req testRequest) ([]map[string]any, error) {
+				switch req.Entity + "." + req.Action {
+				case "OptionValue.get":
+					return []map[string]any{role}, nil
+				case "ACLEntityRole.get":
+					return []map[string]any{entityRole}, nil
+				case "OptionValue.update":
+					sent[req.Entity], _ = req.Params["values"].(map[string]any)
+					maps.Copy(role, sent[req.Entity])
+					return []map[string]any{role}, nil
+				case "ACLEntityRole.update":
+					sent[req.Entity], _ = req.Params["values"].(map[string]any)
+					maps.Copy(entityRole, sent[req.Entity])
+					return []map[string]any{entityRole}, nil
+				}
+				t.Errorf("unexpected request %s.%s", req.Entity, req.Action)
+				return nil, errors.New("unexpected request")
+			})
+
+			r := &ACLAssignmentResource{}
+			s := newTestResource(t, r, client)
+
+			configured := ACLAssignmentResourceModel{
+				ID:              types.Int64Value(15),
+				RoleName:        types.StringValue("volunteer_coordinator"),
+				RoleLabel:       types.StringValue("Volunteer Coordinator"),
+				RoleDescription: types.StringNull(),
+				RoleValue:       types.StringValue("5"),
+				GroupID:         types.Int64Value(8),
+				EntityRoleID:    types.Int64Value(12),
+				IsActive:        types.BoolValue(true),
+			}
+			state := testState(t, s, configured)
+
+			readResp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, readResp)
+			if readResp.Diagnostics.HasError() {
+				t.Fatalf("Read: %v", readResp.Diagnostics)
+			}
+
+			var read ACLAssignmentResourceModel
+			readResp.State.Get(ctx, &read)
+			if read.IsActive.ValueBool() != tt.wantActive {
+				t.Fatalf("is_active after Read = %s, want %t", read.IsActive, tt.wantActive)
+			}
+
+			// Applying the configuration re-enables both rows
+			config := configured
+			config.ID = types.Int64Null()
+			config.RoleValue = types.StringNull()
+			config.EntityRoleID = types.Int64Null()
+
+			updateResp := &resource.UpdateResponse{State: readResp.State}
+			r.Update(ctx, resource.UpdateRequest{
+				Plan:   testPlan(t, s, configured),
+				Config: testConfig(t, s, config),
+				State:  readResp.State,
+			}, updateResp)
+			if updateResp.Diagnostics.HasError() {
+				t.Fatalf("Update: %v", updateResp.Diagnostics)
+			}
+			for _, entity := range []string{"OptionValue", "ACLEntityRole"} {
+				if sent[entity]["is_active"] != true {
+					t.Errorf("%s update sent is_active %v, want true", entity, sent[entity]["is_active"])
+				}
+			}
+
+			var updated ACLAssignmentResourceModel
+			updateResp.State.Get(ctx, &updated)
+			if !updated.IsActive.ValueBool() {
+				t.Errorf("is_active after Update = %s, want true", updated.IsActive)
+			}
+		})
+	}
+}
@@ -120,7 +120,7 @@ func (r *ACLEntityRoleResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	// Call API
-	result, err := r.client.Create("ACLEntityRole", values)
+	result, err := r.client.Create(ctx, "ACLEntityRole", values)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating ACL entity role",
@@ -170,7 +170,9 @@ func (r *ACLEntityRoleResource) Read(ctx context.Context, req resource.ReadReque
 		"id": state.ID.ValueInt64(),
 	})
 
-	result, err := r.client.GetByID("ACLEntityRole", state.ID.ValueInt64(), nil)
+	results, err := r.client.Get(ctx, "ACLEntityRole", [][]any{
+		{"id", "=", state.ID.ValueInt64()},
+	}, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading ACL entity role",
@@ -179,7 +181,52 @@ func (r *ACLEntityRoleResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	// The assignment may have been deleted and recreated outside of Terraform
+	// (e.g. in the CiviCRM UI), which gives it a new ID. Fall back to looking it
+	// up by its role/entity combination before treating it as gone.
+	if len(results) == 0 {
+		tflog.Debug(ctx, "ACL entity role not found by ID, looking up by combination", map[string]any{
+			"id":           state.ID.ValueInt64(),
+			"acl_role_id":  state.ACLRoleID.ValueInt64(),
+			"entity_table": state.EntityTable.ValueString(),
+			"entity_id":    state.EntityID.ValueInt64(),
+		})
+
+		results, err = r.client.Get(ctx, "ACLEntityRole", [][]any{
+			{"acl_role_id", "=", state.ACLRoleID.ValueInt64()},
+			{"entity_table", "=", state.EntityTable.ValueString()},
+			{"entity_id", "=", state.EntityID.ValueInt64()},
+		}, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading ACL entity role",
+				"Could not look up ACL entity role by combination: "+err.Error(),
+			)
+			return
+		}
+
+		if len(results) == 0 {
+			tflog.Warn(ctx, "ACL entity role no longer exists, removing from state", map[string]any{
+				"id": state.ID.ValueInt64(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	}
+
+	result := results[0]
+
 	// Update state
+	if id, ok := GetInt64(result, "id"); ok {
+		if id != state.ID.ValueInt64() {
+			tflog.Info(ctx, "Adopting recreated ACL entity role", map[string]any{
+				"old_id": state.ID.ValueInt64(),
+				"new_id": id,
+			})
+		}
+		state.ID = types.Int64Value(id)
+	}
+
 	if aclRoleID, ok := GetInt64(result, "acl_role_id"); ok {
 		state.ACLRoleID = types.Int64Value(aclRoleID)
 	}
@@ -192,7 +239,15 @@ func (r *ACLEntityRoleResource) Read(ctx context.Context, req resource.ReadReque
 		state.EntityID = types.Int64Value(entityID)
 	}
 
+	// Reflect an assignment enabled or disabled outside of Terraform, so that
+	// the next plan shows the change back to the configured value
 	if active, ok := GetBool(result, "is_active"); ok {
+		if !state.IsActive.IsNull() && state.IsActive.ValueBool() != active {
+			tflog.Info(ctx, "ACL entity role is_active was changed outside of Terraform", map[string]any{
+				"id":        state.ID.ValueInt64(),
+				"is_active": active,
+			})
+		}
 		state.IsActive = types.BoolValue(active)
 	}
 
@@ -228,7 +283,7 @@ func (r *ACLEntityRoleResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	// Call API
-	result, err := r.client.Update("ACLEntityRole", state.ID.ValueInt64(), values)
+	result, err := r.client.Update(ctx, "ACLEntityRole", state.ID.ValueInt64(), values)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating ACL entity role",
@@ -276,7 +331,7 @@ func (r *ACLEntityRoleResource) Delete(ctx context.Context, req resource.DeleteR
 		"id": state.ID.ValueInt64(),
 	})
 
-	err := r.client.Delete("ACLEntityRole", state.ID.ValueInt64())
+	err := r.client.Delete(ctx, "ACLEntityRole", state.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting ACL entity role",
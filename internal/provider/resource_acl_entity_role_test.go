@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestACLEntityRoleResourceIsActiveChangedOutside(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured bool
+	}{
+		{name: "disabled outside", configured: true},
+		{name: "enabled outside", configured: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			// The assignment was toggled in the CiviCRM UI
+			record := map[string]any{
+				"id": 12, "acl_role_id": 5, "entity_table": "civicrm_group", "entity_id": 8,
+				"is_active": !tt.configured,
+			}
+			var sent map[string]any
+			client := newTestClient(t, func(req testRequest) ([]map[string]any, error) {
+				switch req.Entity + "." + req.Action {
+				case "ACLEntityRole.get":
+					return []map[string]any{record}, nil
+				case "ACLEntityRole.update":
+					sent, _ = req.Params["values"].(map[string]any)
+					maps.Copy(record, sent)
+					return []map[string]any{record}, nil
+				}
+				t.Errorf("unexpected request %s.%s", req.Entity, req.Action)
+				return nil, errors.New("unexpected request")
+			})
+
+			r := &ACLEntityRoleResource{}
+			s := newTestResource(t, r, client)
+
+			configured := ACLEntityRoleResourceModel{
+				ID:          types.Int64Value(12),
+				ACLRoleID:   types.Int64Value(5),
+				EntityTable: types.StringValue("civicrm_group"),
+				EntityID:    types.Int64Value(8),
+				IsActive:    types.BoolValue(tt.configured),
+			}
+			state := testState(t, s, configured)
+
+			readResp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, readResp)
+			if readResp.Diagnostics.HasError() {
+				t.Fatalf("Read: %v", readResp.Diagnostics)
+			}
+
+			// Read reports the current value, so the plan shows the change back
+			// to the configured value instead of it flipping silently
+			var read ACLEntityRoleResourceModel
+			readResp.State.Get(ctx, &read)
+			if read.IsActive.ValueBool() != !tt.configured {
+				t.Fatalf("is_active after Read = %s, want %t", read.IsActive, !tt.configured)
+			}
+
+			config := configured
+			config.ID = types.Int64Null()
+
+			updateResp := &resource.UpdateResponse{State: readResp.State}
+			r.Update(ctx, resource.UpdateRequest{
+				Plan:   testPlan(t, s, configured),
+				Config: testConfig(t, s, config),
+				State:  readResp.State,
+			}, updateResp)
+			if updateResp.Diagnostics.HasError() {
+				t.Fatalf("Update: %v", updateResp.Diagnostics)
+			}
+			if sent["is_active"] != tt.configured {
+				t.Errorf("update sent is_active %v, want %t", sent["is_active"], tt.configured)
+			}
+
+			var updated ACLEntityRoleResourceModel
+			updateResp.State.Get(ctx, &updated)
+			if updated.IsActive.ValueBool() != tt.configured {
+				t.Errorf("is_active after Update = %s, want %t", updated.IsActive, tt.configured)
+			}
+		})
+	}
+}
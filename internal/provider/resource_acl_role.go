@@ -2,15 +2,18 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -19,6 +22,7 @@ var (
 	_ resource.Resource                = &ACLRoleResource{}
 	_ resource.ResourceWithConfigure   = &ACLRoleResource{}
 	_ resource.ResourceWithImportState = &ACLRoleResource{}
+	_ resource.ResourceWithModifyPlan  = &ACLRoleResource{}
 )
 
 // ACLRoleResource manages ACL roles in CiviCRM.
@@ -34,9 +38,14 @@ type ACLRoleResourceModel struct {
 	Description types.String `tfsdk:"description"`
 	IsActive    types.Bool   `tfsdk:"is_active"`
 	Weight      types.Int64  `tfsdk:"weight"`
+	Order       types.Int64  `tfsdk:"order"`
+	Filter      types.Int64  `tfsdk:"filter"`
 	Value       types.String `tfsdk:"value"`
 }
 
+// aclRoleSelect selects the OptionValue fields of an ACL role that Read uses.
+var aclRoleSelect = []string{"id", "name", "label", "value", "description", "weight", "is_active"}
+
 func NewACLRoleResource() resource.Resource {
 	return &ACLRoleResource{}
 }
@@ -75,10 +84,22 @@ func (r *ACLRoleResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Default:     booldefault.StaticBool(true),
 			},
 			"weight": schema.Int64Attribute{
-				Description: "The sort weight of the ACL role.",
+				Description: "The sort weight of the ACL role. Assigned by CiviCRM unless set here or through order.",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
+			"order": schema.Int64Attribute{
+				Description: "The explicit position of the ACL role, stored as its weight. Changes to the weight made outside of Terraform are reverted. Conflicts with weight.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+					int64validator.ConflictsWith(path.MatchRoot("weight")),
+				},
+			},
+			"filter": optionValueFilterAttribute("ACL role"),
 			"value": schema.StringAttribute{
 				Description: "The value of the ACL role (used internally by CiviCRM).",
 				Computed:    true,
@@ -87,6 +108,36 @@ func (r *ACLRoleResource) Schema(ctx context.Context, req resource.SchemaRequest
 	}
 }
 
+// ModifyPlan plans the weight that an explicit order will be stored as.
+func (r *ACLRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var order types.Int64
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("order"), &order)...)
+	if resp.Diagnostics.HasError() || order.IsNull() || order.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("weight"), order)...)
+}
+
+// aclRoleWeight returns the weight to send for plan: the explicit order if set,
+// otherwise a known weight. ok is false when CiviCRM should assign the weight.
+func aclRoleWeight(plan ACLRoleResourceModel) (int64, bool) {
+	if !plan.Order.IsNull() && !plan.Order.IsUnknown() {
+		return plan.Order.ValueInt64(), true
+	}
+
+	if !plan.Weight.IsNull() && !plan.Weight.IsUnknown() {
+		return plan.Weight.ValueInt64(), true
+	}
+
+	return 0, false
+}
+
 func (r *ACLRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -118,7 +169,7 @@ func (r *ACLRoleResource) Create(ctx context.Context, req resource.CreateRequest
 	})
 
 	// Look up the acl_role option group ID
-	optionGroupID, err := r.client.GetOptionGroupID("acl_role")
+	optionGroupID, err := r.client.GetOptionGroupID(ctx, "acl_role")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error looking up option group",
@@ -140,12 +191,14 @@ func (r *ACLRoleResource) Create(ctx context.Context, req resource.CreateRequest
 		values["description"] = plan.Description.ValueString()
 	}
 
-	if !plan.Weight.IsNull() {
-		values["weight"] = plan.Weight.ValueInt64()
+	if weight, ok := aclRoleWeight(plan); ok {
+		values["weight"] = weight
 	}
 
+	setOptionValueFilter(values, plan.Filter, false)
+
 	// Call API
-	result, err := r.client.Create("OptionValue", values)
+	result, err := r.client.Create(ctx, "OptionValue", values)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating ACL role",
@@ -185,6 +238,8 @@ func (r *ACLRoleResource) Create(ctx context.Context, req resource.CreateRequest
 		plan.Value = types.StringValue(value)
 	}
 
+	plan.Filter = optionValueFilter(result)
+
 	tflog.Debug(ctx, "Created ACL role", map[string]any{
 		"id": plan.ID.ValueInt64(),
 	})
@@ -205,7 +260,14 @@ func (r *ACLRoleResource) Read(ctx context.Context, req resource.ReadRequest, re
 		"id": state.ID.ValueInt64(),
 	})
 
-	result, err := r.client.GetByID("OptionValue", state.ID.ValueInt64(), nil)
+	result, err := r.client.GetByID(ctx, "OptionValue", state.ID.ValueInt64(), aclRoleSelect)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "ACL role no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading ACL role",
@@ -223,11 +285,7 @@ func (r *ACLRoleResource) Read(ctx context.Context, req resource.ReadRequest, re
 		state.Label = types.StringValue(label)
 	}
 
-	if desc, ok := GetString(result, "description"); ok && desc != "" {
-		state.Description = types.StringValue(desc)
-	} else {
-		state.Description = types.StringNull()
-	}
+	state.Description = r.client.optionalString(result, "description", state.Description)
 
 	if active, ok := GetBool(result, "is_active"); ok {
 		state.IsActive = types.BoolValue(active)
@@ -235,12 +293,19 @@ func (r *ACLRoleResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	if weight, ok := GetInt64(result, "weight"); ok {
 		state.Weight = types.Int64Value(weight)
+
+		// Surface weight changes made outside of Terraform as a diff on order
+		if !state.Order.IsNull() && state.Order.ValueInt64() != weight {
+			state.Order = types.Int64Value(weight)
+		}
 	}
 
 	if value, ok := GetString(result, "value"); ok {
 		state.Value = types.StringValue(value)
 	}
 
+	state.Filter = optionValueFilter(result)
+
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -277,12 +342,14 @@ func (r *ACLRoleResource) Update(ctx context.Context, req resource.UpdateRequest
 		values["description"] = nil
 	}
 
-	if !plan.Weight.IsNull() {
-		values["weight"] = plan.Weight.ValueInt64()
+	if weight, ok := aclRoleWeight(plan); ok {
+		values["weight"] = weight
 	}
 
+	setOptionValueFilter(values, plan.Filter, true)
+
 	// Call API
-	result, err := r.client.Update("OptionValue", state.ID.ValueInt64(), values)
+	result, err := r.client.Update(ctx, "OptionValue", state.ID.ValueInt64(), values)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating ACL role",
@@ -302,11 +369,7 @@ func (r *ACLRoleResource) Update(ctx context.Context, req resource.UpdateRequest
 		plan.Label = types.StringValue(label)
 	}
 
-	if desc, ok := GetString(result, "description"); ok && desc != "" {
-		plan.Description = types.StringValue(desc)
-	} else {
-		plan.Description = types.StringNull()
-	}
+	plan.Description = r.client.optionalString(result, "description", plan.Description)
 
 	if active, ok := GetBool(result, "is_active"); ok {
 		plan.IsActive = types.BoolValue(active)
@@ -320,6 +383,8 @@ func (r *ACLRoleResource) Update(ctx context.Context, req resource.UpdateRequest
 		plan.Value = types.StringValue(value)
 	}
 
+	plan.Filter = optionValueFilter(result)
+
 	tflog.Debug(ctx, "Updated ACL role", map[string]any{
 		"id": plan.ID.ValueInt64(),
 	})
@@ -340,7 +405,7 @@ func (r *ACLRoleResource) Delete(ctx context.Context, req resource.DeleteRequest
 		"id": state.ID.ValueInt64(),
 	})
 
-	err := r.client.Delete("OptionValue", state.ID.ValueInt64())
+	err := r.client.Delete(ctx, "OptionValue", state.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting ACL role",
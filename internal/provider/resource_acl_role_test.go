@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestACLRoleResourceWeightModes(t *testing.T) {
+	tests := []struct {
+		name string
+		// order and weight are the configured values
+		order  types.Int64
+		weight types.Int64
+		// wantSent is the weight sent on create, nil for a server-assigned one
+		wantSent       any
+		wantWeight     int64
+		wantReadOrder  types.Int64
+		wantReadWeight int64
+	}{
+		{
+			name:           "server-managed",
+			order:          types.Int64Null(),
+			weight:         types.Int64Null(),
+			wantSent:       nil,
+			wantWeight:     4,
+			wantReadOrder:  types.Int64Null(),
+			wantReadWeight: 9,
+		},
+		{
+			name:           "explicit order",
+			order:          types.Int64Value(2),
+			weight:         types.Int64Null(),
+			wantSent:       float64(2),
+			wantWeight:     2,
+			wantReadOrder:  types.Int64Value(9),
+			wantReadWeight: 9,
+		},
+		{
+			name:           "explicit order 0",
+			order:          types.Int64Value(0),
+			weight:         types.Int64Null(),
+			wantSent:       float64(0),
+			wantWeight:     0,
+			wantReadOrder:  types.Int64Value(9),
+			wantReadWeight: 9,
+		},
+		{
+			name:           "explicit weight",
+			order:          types.Int64Null(),
+			weight:         types.Int64Value(6),
+			wantSent:       float64(6),
+			wantWeight:     6,
+			wantReadOrder:  types.Int64Null(),
+			wantReadWeight: 9,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			record := map[string]any{
+				"id": 15, "name": "volunteer_coordinator", "label": "Volunteer Coordinator",
+				"value": "5", "description": nil, "is_active": true, "filter": 0,
+			}
+			var sent any
+			client := newTestClient(t, func(req testRequest) ([]map[string]any, error) {
+				switch req.Entity + "." + req.Action {
+				case "OptionGroup.get":
+					return []map[string]any{{"id": 2}}, nil
+				case "OptionValue.create":
+					values, _ := req.Params["values"].(map[string]any)
+					sent = values["weight"]
+					record["weight"] = 4
+					if sent != nil {
+						record["weight"] = sent
+					}
+					return []map[string]any{record}, nil
+				case "OptionValue.get":
+					return []map[string]any{record}, nil
+				}
+				t.Errorf("unexpected request %s.%s", req.Entity, req.Action)
+				return nil, errors.New("unexpected request")
+			})
+
+			r := &ACLRoleResource{}
+			s := newTestResource(t, r, client)
+
+			config := ACLRoleResourceModel{
+				ID:          types.Int64Null(),
+				Name:        types.StringValue("volunteer_coordinator"),
+				Label:       types.StringValue("Volunteer Coordinator"),
+				Description: types.StringNull(),
+				IsActive:    types.BoolNull(),
+				Weight:      tt.weight,
+				Order:       tt.order,
+				Filter:      types.Int64Null(),
+				Value:       types.StringNull(),
+			}
+			plan := config
+			plan.ID = types.Int64Unknown()
+			plan.IsActive = types.BoolValue(true)
+			plan.Value = types.StringUnknown()
+			if plan.Weight.IsNull() {
+				plan.Weight = types.Int64Unknown()
+			}
+
+			modifyReq := resource.ModifyPlanRequest{
+				Config: testConfig(t, s, config),
+				Plan:   testPlan(t, s, plan),
+				State:  emptyTestState(s),
+			}
+			modifyResp := &resource.ModifyPlanResponse{Plan: modifyReq.Plan}
+			r.ModifyPlan(ctx, modifyReq, modifyResp)
+			if modifyResp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan: %v", modifyResp.Diagnostics)
+			}
+
+			createResp := &resource.CreateResponse{State: emptyTestState(s)}
+			r.Create(ctx, resource.CreateRequest{Plan: modifyResp.Plan, Config: modifyReq.Config}, createResp)
+			if createResp.Diagnostics.HasError() {
+				t.Fatalf("Create: %v", createResp.Diagnostics)
+			}
+			if sent != tt.wantSent {
+				t.Errorf("create sent weight %v, want %v", sent, tt.wantSent)
+			}
+
+			var created ACLRoleResourceModel
+			createResp.State.Get(ctx, &created)
+			if created.Weight.ValueInt64() != tt.wantWeight {
+				t.Errorf("weight after Create = %s, want %d", created.Weight, tt.wantWeight)
+			}
+			if !created.Order.Equal(tt.order) {
+				t.Errorf("order after Create = %s, want %s", created.Order, tt.order)
+			}
+
+			// The weight is changed outside of Terraform
+			record["weight"] = 9
+
+			readResp := &resource.ReadResponse{State: createResp.State}
+			r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+			if readResp.Diagnostics.HasError() {
+				t.Fatalf("Read: %v", readResp.Diagnostics)
+			}
+
+			var read ACLRoleResourceModel
+			readResp.State.Get(ctx, &read)
+			if read.Weight.ValueInt64() != tt.wantReadWeight {
+				t.Errorf("weight after Read = %s, want %d", read.Weight, tt.wantReadWeight)
+			}
+			if !read.Order.Equal(tt.wantReadOrder) {
+				t.Errorf("order after Read = %s, want %s", read.Order, tt.wantReadOrder)
+			}
+		})
+	}
+}
+
+func TestACLRoleResourceOrderValidator(t *testing.T) {
+	r := &ACLRoleResource{}
+	s := newTestResource(t, r, nil)
+
+	attribute, ok := s.Attributes["order"].(schema.Int64Attribute)
+	if !ok {
+		t.Fatalf("order is a %T, want schema.Int64Attribute", s.Attributes["order"])
+	}
+
+	config := ACLRoleResourceModel{
+		ID:          types.Int64Null(),
+		Name:        types.StringValue("volunteer_coordinator"),
+		Label:       types.StringValue("Volunteer Coordinator"),
+		Description: types.StringNull(),
+		IsActive:    types.BoolNull(),
+		Weight:      types.Int64Null(),
+		Filter:      types.Int64Null(),
+		Value:       types.StringNull(),
+	}
+
+	for _, tt := range []struct {
+		order   int64
+		wantErr bool
+	}{
+		{order: 0},
+		{order: 3},
+		{order: -1, wantErr: true},
+	} {
+		config.Order = types.Int64Value(tt.order)
+
+		resp := &validator.Int64Response{}
+		for _, v := range attribute.Validators {
+			v.ValidateInt64(context.Background(), validator.Int64Request{
+				Path:        path.Root("order"),
+				Config:      testConfig(t, s, config),
+				ConfigValue: config.Order,
+			}, resp)
+		}
+		if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+			t.Errorf("order = %d: error = %t, want %t: %v", tt.order, got, tt.wantErr, resp.Diagnostics)
+		}
+	}
+}
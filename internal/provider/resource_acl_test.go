@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestACLResourceAllObjectsIsStable(t *testing.T) {
+	tests := []struct {
+		name           string
+		configObjectID types.Int64
+		storedObjectID any
+	}{
+		{name: "stored as null", configObjectID: types.Int64Null(), storedObjectID: nil},
+		{name: "stored as 0", configObjectID: types.Int64Null(), storedObjectID: 0},
+		{name: "configured as 0 and stored as null", configObjectID: types.Int64Value(0), storedObjectID: nil},
+		{name: "configured as 0 and stored as 0", configObjectID: types.Int64Value(0), storedObjectID: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			record := map[string]any{
+				"id":           31,
+				"name":         "View all groups",
+				"entity_table": "civicrm_acl_role",
+				"entity_id":    3,
+				"operation":    "View",
+				"object_table": "civicrm_group",
+				"object_id":    tt.storedObjectID,
+				"acl_table":    nil,
+				"acl_id":       nil,
+				"deny":         false,
+				"priority":     0,
+				"is_active":    true,
+			}
+			client := newTestClient(t, func(req testRequest) ([]map[string]any, error) {
+				switch req.Entity + "." + req.Action {
+				case "ACL.create", "ACL.get":
+					return []map[string]any{record}, nil
+				}
+				t.Errorf("unexpected request %s.%s", req.Entity, req.Action)
+				return nil, errors.New("unexpected request")
+			})
+
+			r := &ACLResource{}
+			s := newTestResource(t, r, client)
+
+			plan := ACLResourceModel{
+				ID:           types.Int64Unknown(),
+				Name:         types.StringValue("View all groups"),
+				Deny:         types.BoolValue(false),
+				EntityTable:  types.StringValue("civicrm_acl_role"),
+				EntityID:     types.Int64Value(3),
+				Operation:    types.StringValue("View"),
+				Operations:   types.ListNull(types.StringType),
+				OperationIDs: types.MapNull(types.Int64Type),
+				ObjectTable:  types.StringValue("civicrm_group"),
+				ObjectID:     tt.configObjectID,
+				AclTable:     types.StringNull(),
+				AclID:        types.Int64Null(),
+				IsActive:     types.BoolValue(true),
+				Priority:     types.Int64Unknown(),
+			}
+
+			createResp := &resource.CreateResponse{State: emptyTestState(s)}
+			r.Create(ctx, resource.CreateRequest{Plan: testPlan(t, s, plan), Config: testConfig(t, s, plan)}, createResp)
+			if createResp.Diagnostics.HasError() {
+				t.Fatalf("Create: %v", createResp.Diagnostics)
+			}
+
+			var created ACLResourceModel
+			createResp.State.Get(ctx, &created)
+			if !created.ObjectID.Equal(tt.configObjectID) {
+				t.Errorf("object_id after Create = %s, want %s", created.ObjectID, tt.configObjectID)
+			}
+
+			readResp := &resource.ReadResponse{State: createResp.State}
+			r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+			if readResp.Diagnostics.HasError() {
+				t.Fatalf("Read: %v", readResp.Diagnostics)
+			}
+
+			if !createResp.State.Raw.Equal(readResp.State.Raw) {
+				t.Errorf("Read changed the state:\ncreate: %s\nread:   %s", createResp.State.Raw, readResp.State.Raw)
+			}
+		})
+	}
+}
+
+func TestACLObjectIDValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		stored  any
+		current types.Int64
+		want    types.Int64
+	}{
+		{name: "null for null", stored: nil, current: types.Int64Null(), want: types.Int64Null()},
+		{name: "0 for null", stored: 0, current: types.Int64Null(), want: types.Int64Null()},
+		{name: "0 for unknown", stored: 0, current: types.Int64Unknown(), want: types.Int64Null()},
+		{name: "null for 0", stored: nil, current: types.Int64Value(0), want: types.Int64Value(0)},
+		{name: "0 for 0", stored: 0, current: types.Int64Value(0), want: types.Int64Value(0)},
+		{name: "object", stored: 42, current: types.Int64Null(), want: types.Int64Value(42)},
+		{name: "changed object", stored: 43, current: types.Int64Value(42), want: types.Int64Value(43)},
+		{name: "object removed", stored: nil, current: types.Int64Value(42), want: types.Int64Null()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := aclObjectIDValue(map[string]any{"object_id": tt.stored}, tt.current)
+			if !got.Equal(tt.want) {
+				t.Errorf("aclObjectIDValue() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestACLResourceUpdateRollsBackCreatedRules(t *testing.T) {
+	ctx := context.Background()
+
+	record := map[string]any{
+		"id": 31, "name": "Edit volunteers", "entity_table": "civicrm_acl_role", "entity_id": 3,
+		"operation": "View", "object_table": "civicrm_group", "object_id": 8, "acl_table": nil,
+		"acl_id": nil, "deny": false, "priority": 0, "is_active": true,
+	}
+	var deleted []any
+	creates := 0
+	client := newTestClient(t, func(req testRequest) ([]map[string]any, error) {
+		switch req.Entity + "." + req.Action {
+		case "ACL.update":
+			return []map[string]any{record}, nil
+		case "ACL.create":
+			creates++
+			if creates > 1 {
+				return nil, errors.New("permission denied")
+			}
+			return []map[string]any{{"id": 32, "operation": "Edit"}}, nil
+		case "ACL.delete":
+			where, _ := req.Params["where"].([]any)
+			clause, _ := where[0].([]any)
+			deleted = append(deleted, clause[2])
+			return []map[string]any{}, nil
+		}
+		t.Errorf("unexpected request %s.%s", req.Entity, req.Action)
+		return nil, errors.New("unexpected request")
+	})
+
+	r := &ACLResource{}
+	s := newTestResource(t, r, client)
+
+	stateOperations, _ := types.ListValueFrom(ctx, types.StringType, []string{"View"})
+	operationIDs, _ := types.MapValueFrom(ctx, types.Int64Type, map[string]int64{"View": 31})
+	prior := ACLResourceModel{
+		ID:           types.Int64Value(31),
+		Name:         types.StringValue("Edit volunteers"),
+		Deny:         types.BoolValue(false),
+		EntityTable:  types.StringValue("civicrm_acl_role"),
+		EntityID:     types.Int64Value(3),
+		Operation:    types.StringNull(),
+		Operations:   stateOperations,
+		OperationIDs: operationIDs,
+		ObjectTable:  types.StringValue("civicrm_group"),
+		ObjectID:     types.Int64Value(8),
+		AclTable:     types.StringNull(),
+		AclID:        types.Int64Null(),
+		IsActive:     types.BoolValue(true),
+		Priority:     types.Int64Value(0),
+	}
+	state := testState(t, s, prior)
+
+	plan := prior
+	plan.Operations, _ = types.ListValueFrom(ctx, types.StringType, []string{"View", "Edit", "Delete"})
+	plan.OperationIDs = types.MapUnknown(types.Int64Type)
+
+	updateResp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: testPlan(t, s, plan), Config: testConfig(t, s, plan), State: state}, updateResp)
+	if !updateResp.Diagnostics.HasError() {
+		t.Fatal("Update succeeded, want an error")
+	}
+	if len(deleted) != 1 || deleted[0] != float64(32) {
+		t.Errorf("deleted the rules %v, want the created rule 32", deleted)
+	}
+}
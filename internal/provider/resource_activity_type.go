@@ -0,0 +1,315 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &ActivityTypeResource{}
+	_ resource.ResourceWithConfigure      = &ActivityTypeResource{}
+	_ resource.ResourceWithImportState    = &ActivityTypeResource{}
+	_ resource.ResourceWithValidateConfig = &ActivityTypeResource{}
+)
+
+// ActivityTypeResource manages activity types in CiviCRM.
+// Activity types are stored as OptionValues in the "activity_type" option group.
+type ActivityTypeResource struct {
+	optionValues optionValueCRUD
+}
+
+// ActivityTypeResourceModel holds the attributes of optionValueModel and the
+// icon and component of the activity type, see optionValue.
+type ActivityTypeResourceModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Label        types.String `tfsdk:"label"`
+	Description  types.String `tfsdk:"description"`
+	Icon         types.String `tfsdk:"icon"`
+	Component    types.String `tfsdk:"component"`
+	Weight       types.Int64  `tfsdk:"weight"`
+	IsActive     types.Bool   `tfsdk:"is_active"`
+	Filter       types.Int64  `tfsdk:"filter"`
+	Value        types.String `tfsdk:"value"`
+	IgnoreFields types.List   `tfsdk:"ignore_fields"`
+}
+
+func NewActivityTypeResource() resource.Resource {
+	return &ActivityTypeResource{
+		optionValues: optionValueCRUD{
+			optionGroup:      "activity_type",
+			noun:             "activity type",
+			valueDescription: "The value of the activity type, which activities store as their activity_type_id.",
+		},
+	}
+}
+
+func (r *ActivityTypeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_activity_type"
+}
+
+func (r *ActivityTypeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := r.optionValues.schemaAttributes()
+	attributes["icon"] = schema.StringAttribute{
+		Description: "FontAwesome icon class of the activity type (e.g., 'fa-phone').",
+		Optional:    true,
+	}
+	attributes["component"] = schema.StringAttribute{
+		Description: "The component the activity type belongs to, which limits it to the records of that component (e.g., 'CiviCase', 'CiviEvent'). Leave unset for activity types that apply to contacts.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("CiviEvent", "CiviContribute", "CiviMember", "CiviMail", "CiviCase", "CiviPledge", "CiviCampaign", "CiviGrant", "CiviReport"),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a CiviCRM activity type, such as 'Phone Call' or 'Volunteer Shift'.",
+		Attributes:  attributes,
+	}
+}
+
+func (r *ActivityTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.optionValues.client = client
+}
+
+// ValidateConfig rejects ignore_fields entries that name no attribute or one
+// that cannot be ignored.
+func (r *ActivityTypeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateIgnoredFields(ctx, req.Config, &resp.Diagnostics)
+}
+
+func (r *ActivityTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ActivityTypeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating activity type", map[string]any{
+		"name":  plan.Name.ValueString(),
+		"label": plan.Label.ValueString(),
+	})
+
+	weight := configuredOptionValueWeight(ctx, req.Config, &resp.Diagnostics)
+	optionValue := plan.optionValue()
+	if err := r.optionValues.create(ctx, &optionValue, weight, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating activity type",
+			"Could not create activity type, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	plan.setOptionValue(optionValue)
+
+	tflog.Debug(ctx, "Created activity type", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ActivityTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ActivityTypeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading activity type", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	optionValue := state.optionValue()
+	err := r.optionValues.read(ctx, &optionValue, &state)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Activity type no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading activity type",
+			"Could not read activity type ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+	state.setOptionValue(optionValue)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+
+	// Keep the prior value of attributes managed outside of Terraform
+	ignored := ignoredFields(ctx, state.IgnoreFields, &resp.Diagnostics)
+	keepIgnoredFields(ctx, ignored, req.State, &resp.State, &resp.Diagnostics)
+}
+
+func (r *ActivityTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ActivityTypeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ActivityTypeResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating activity type", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	weight := configuredOptionValueWeight(ctx, req.Config, &resp.Diagnostics)
+	ignored := ignoredFields(ctx, plan.IgnoreFields, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	optionValue := plan.optionValue()
+	if err := r.optionValues.update(ctx, state.ID.ValueInt64(), &optionValue, weight, &plan, ignored); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating activity type",
+			"Could not update activity type ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+	plan.setOptionValue(optionValue)
+
+	tflog.Debug(ctx, "Updated activity type", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	keepIgnoredFields(ctx, ignored, req.Plan, &resp.State, &resp.Diagnostics)
+}
+
+func (r *ActivityTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ActivityTypeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting activity type", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	if err := r.optionValues.delete(ctx, state.ID.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting activity type",
+			"Could not delete activity type ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted activity type", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+}
+
+func (r *ActivityTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Could not parse import ID as integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// optionValue returns the attributes of the model that optionValueCRUD
+// manages.
+func (m *ActivityTypeResourceModel) optionValue() optionValueModel {
+	return optionValueModel{
+		ID:           m.ID,
+		Name:         m.Name,
+		Label:        m.Label,
+		Description:  m.Description,
+		IsActive:     m.IsActive,
+		Weight:       m.Weight,
+		Filter:       m.Filter,
+		Value:        m.Value,
+		IgnoreFields: m.IgnoreFields,
+	}
+}
+
+// setOptionValue copies the attributes managed by optionValueCRUD back into
+// the model.
+func (m *ActivityTypeResourceModel) setOptionValue(optionValue optionValueModel) {
+	m.ID = optionValue.ID
+	m.Name = optionValue.Name
+	m.Label = optionValue.Label
+	m.Description = optionValue.Description
+	m.IsActive = optionValue.IsActive
+	m.Weight = optionValue.Weight
+	m.Filter = optionValue.Filter
+	m.Value = optionValue.Value
+	m.IgnoreFields = optionValue.IgnoreFields
+}
+
+// selectFields implements optionValueExtension.
+func (m *ActivityTypeResourceModel) selectFields() []string {
+	return []string{"icon", "component_id:name"}
+}
+
+// buildValues implements optionValueExtension.
+func (m *ActivityTypeResourceModel) buildValues(values map[string]any, update bool) {
+	if !m.Icon.IsNull() {
+		values["icon"] = m.Icon.ValueString()
+	} else if update {
+		values["icon"] = nil
+	}
+
+	if !m.Component.IsNull() {
+		values["component_id:name"] = m.Component.ValueString()
+	} else if update {
+		values["component_id"] = nil
+	}
+}
+
+// mapResponse implements optionValueExtension.
+func (m *ActivityTypeResourceModel) mapResponse(client *Client, result map[string]any) {
+	m.Icon = client.optionalString(result, "icon", m.Icon)
+
+	if component, ok := GetString(result, "component_id:name"); ok && component != "" {
+		m.Component = types.StringValue(component)
+	} else {
+		m.Component = types.StringNull()
+	}
+}
@@ -0,0 +1,393 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &AfformResource{}
+	_ resource.ResourceWithConfigure   = &AfformResource{}
+	_ resource.ResourceWithImportState = &AfformResource{}
+)
+
+// afformLayoutWhitespace matches the whitespace between tags, which CiviCRM
+// does not preserve when it stores a layout.
+var afformLayoutWhitespace = regexp.MustCompile(`>\s+<`)
+
+// AfformResource manages FormBuilder forms through the Afform API. Forms are
+// identified by name rather than by a numeric ID.
+type AfformResource struct {
+	client *Client
+}
+
+type AfformResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Title       types.String `tfsdk:"title"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+	Layout      types.String `tfsdk:"layout"`
+	Permission  types.List   `tfsdk:"permission"`
+	ServerRoute types.String `tfsdk:"server_route"`
+	Placement   types.List   `tfsdk:"placement"`
+}
+
+func NewAfformResource() resource.Resource {
+	return &AfformResource{}
+}
+
+func (r *AfformResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_afform"
+}
+
+func (r *AfformResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a CiviCRM FormBuilder (afform) form. Requires the Form Builder extension.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier of the form (same as name).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The machine name of the form (e.g., 'afformVolunteerSignup'). Changing this forces a new form.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`), "must start with a letter and contain only letters, digits and underscores"),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "The title of the form.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of form. Options: 'form', 'block', 'search', 'system'. Default: 'form'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("form"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("form", "block", "search", "system"),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the form for administrators.",
+				Optional:    true,
+			},
+			"layout": schema.StringAttribute{
+				Description: "The layout of the form as HTML, as shown by the FormBuilder 'Export' view.",
+				Required:    true,
+			},
+			"permission": schema.ListAttribute{
+				Description: "The permissions required to use the form (e.g., 'access CiviCRM'). Defaults to the server default when not set.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_route": schema.StringAttribute{
+				Description: "The page path the form is published at (e.g., 'civicrm/volunteer-signup').",
+				Optional:    true,
+			},
+			"placement": schema.ListAttribute{
+				Description: "Where the form is placed besides its page (e.g., 'dashboard_dashlet', 'contact_summary_tab').",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *AfformResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AfformResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AfformResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating afform", map[string]any{
+		"name": plan.Name.ValueString(),
+	})
+
+	values := r.buildValues(ctx, plan, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call API
+	result, err := r.client.SaveAfform(ctx, values)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating afform",
+			"Could not create afform, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update state with response
+	r.mapResponseToModel(ctx, result, &plan, &resp.Diagnostics)
+
+	tflog.Debug(ctx, "Created afform", map[string]any{
+		"id": plan.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *AfformResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AfformResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading afform", map[string]any{
+		"id": state.ID.ValueString(),
+	})
+
+	result, err := r.client.GetAfform(ctx, state.ID.ValueString())
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Afform no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading afform",
+			"Could not read afform "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	r.mapResponseToModel(ctx, result, &state, &resp.Diagnostics)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *AfformResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AfformResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating afform", map[string]any{
+		"id": plan.Name.ValueString(),
+	})
+
+	values := r.buildValues(ctx, plan, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call API
+	result, err := r.client.SaveAfform(ctx, values)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating afform",
+			"Could not update afform "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	r.mapResponseToModel(ctx, result, &plan, &resp.Diagnostics)
+
+	tflog.Debug(ctx, "Updated afform", map[string]any{
+		"id": plan.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *AfformResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AfformResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting afform", map[string]any{
+		"id": state.ID.ValueString(),
+	})
+
+	err := r.client.RevertAfform(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting afform",
+			"Could not delete afform "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted afform", map[string]any{
+		"id": state.ID.ValueString(),
+	})
+}
+
+// ImportState accepts the name of the form.
+func (r *AfformResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
+
+// buildValues builds the Afform record for plan. The Afform API replaces the
+// whole form on save, so on update null optionals are sent as empty values.
+func (r *AfformResource) buildValues(ctx context.Context, plan AfformResourceModel, update bool, diags *diag.Diagnostics) map[string]any {
+	values := map[string]any{
+		"name":   plan.Name.ValueString(),
+		"title":  plan.Title.ValueString(),
+		"type":   plan.Type.ValueString(),
+		"layout": plan.Layout.ValueString(),
+	}
+
+	for _, field := range []struct {
+		key   string
+		value types.String
+	}{
+		{"description", plan.Description},
+		{"server_route", plan.ServerRoute},
+	} {
+		if !field.value.IsNull() {
+			values[field.key] = field.value.ValueString()
+		} else if update {
+			values[field.key] = nil
+		}
+	}
+
+	if !plan.Permission.IsNull() && !plan.Permission.IsUnknown() {
+		var permission []string
+		diags.Append(plan.Permission.ElementsAs(ctx, &permission, false)...)
+		values["permission"] = permission
+	}
+
+	if !plan.Placement.IsNull() {
+		var placement []string
+		diags.Append(plan.Placement.ElementsAs(ctx, &placement, false)...)
+		values["placement"] = placement
+	} else if update {
+		values["placement"] = []string{}
+	}
+
+	return values
+}
+
+func (r *AfformResource) mapResponseToModel(ctx context.Context, result map[string]any, model *AfformResourceModel, diags *diag.Diagnostics) {
+	if name, ok := GetString(result, "name"); ok {
+		model.ID = types.StringValue(name)
+		model.Name = types.StringValue(name)
+	}
+
+	if title, ok := GetString(result, "title"); ok {
+		model.Title = types.StringValue(title)
+	}
+
+	if afformType, ok := GetString(result, "type"); ok {
+		model.Type = types.StringValue(afformType)
+	}
+
+	model.Description = r.client.optionalString(result, "description", model.Description)
+	model.ServerRoute = r.client.optionalString(result, "server_route", model.ServerRoute)
+
+	// Keep the configured layout unless it differs by more than whitespace
+	if layout, ok := GetString(result, "layout"); ok && !afformLayoutEquals(model.Layout.ValueString(), layout) {
+		model.Layout = types.StringValue(layout)
+	}
+
+	// Older CiviCRM versions store a single permission as a string
+	var permission []string
+	switch v := result["permission"].(type) {
+	case string:
+		if v != "" {
+			permission = []string{v}
+		}
+	case []any:
+		for _, p := range v {
+			if s, ok := p.(string); ok {
+				permission = append(permission, s)
+			}
+		}
+	}
+	if len(permission) > 0 {
+		permissionList, d := types.ListValueFrom(ctx, types.StringType, permission)
+		diags.Append(d...)
+		model.Permission = permissionList
+	} else {
+		model.Permission = types.ListNull(types.StringType)
+	}
+
+	var placement []string
+	if placementSlice, ok := result["placement"].([]any); ok {
+		for _, p := range placementSlice {
+			if s, ok := p.(string); ok {
+				placement = append(placement, s)
+			}
+		}
+	}
+	if len(placement) > 0 {
+		placementList, d := types.ListValueFrom(ctx, types.StringType, placement)
+		diags.Append(d...)
+		model.Placement = placementList
+	} else {
+		model.Placement = types.ListNull(types.StringType)
+	}
+}
+
+// afformLayoutEquals reports whether two layouts are the same HTML apart from
+// the whitespace between tags.
+func afformLayoutEquals(a, b string) bool {
+	normalize := func(layout string) string {
+		return afformLayoutWhitespace.ReplaceAllString(strings.TrimSpace(layout), "><")
+	}
+	return normalize(a) == normalize(b)
+}
@@ -0,0 +1,331 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &AttachmentResource{}
+	_ resource.ResourceWithConfigure   = &AttachmentResource{}
+	_ resource.ResourceWithImportState = &AttachmentResource{}
+)
+
+// maxAttachmentSize is the largest file the attachment resource uploads.
+const maxAttachmentSize = 10 << 20
+
+// AttachmentResource manages a file attached to a CiviCRM entity.
+type AttachmentResource struct {
+	client *Client
+}
+
+type AttachmentResourceModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	EntityTable types.String `tfsdk:"entity_table"`
+	EntityID    types.Int64  `tfsdk:"entity_id"`
+	Source      types.String `tfsdk:"source"`
+	SourceHash  types.String `tfsdk:"source_hash"`
+	Name        types.String `tfsdk:"name"`
+	MimeType    types.String `tfsdk:"mime_type"`
+	URL         types.String `tfsdk:"url"`
+}
+
+func NewAttachmentResource() resource.Resource {
+	return &AttachmentResource{}
+}
+
+func (r *AttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_attachment"
+}
+
+func (r *AttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Uploads a local file and attaches it to a CiviCRM entity such as an activity, case or note.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier of the attached file.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"entity_table": schema.StringAttribute{
+				Description: "The table of the entity the file is attached to (e.g., 'civicrm_activity', 'civicrm_case', 'civicrm_note').",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_id": schema.Int64Attribute{
+				Description: "The ID of the entity the file is attached to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Description: "The path of the local file to upload. Files larger than 10 MiB are rejected.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessImported(),
+				},
+			},
+			"source_hash": schema.StringAttribute{
+				Description: "A hash of the file content, e.g. filesha256(source). Changing it re-uploads the file.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessImported(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The file name shown in CiviCRM. Defaults to the base name of source.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mime_type": schema.StringAttribute{
+				Description: "The MIME type of the file.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "The URL to download the file from CiviCRM.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// requiresReplaceUnlessImported forces replacement when the value changes,
+// unless it had no value before. The local source and its hash cannot be read
+// from CiviCRM, so they are unset after an import, and setting them must not
+// upload the file again.
+func requiresReplaceUnlessImported() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull()
+		},
+		"Changing the value re-uploads the file, unless the value was unset, e.g. after an import.",
+		"Changing the value re-uploads the file, unless the value was unset, e.g. after an import.",
+	)
+}
+
+func (r *AttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AttachmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	source := plan.Source.ValueString()
+	if plan.Name.IsUnknown() || plan.Name.IsNull() {
+		plan.Name = types.StringValue(filepath.Base(source))
+	}
+
+	tflog.Debug(ctx, "Creating attachment", map[string]any{
+		"entity_table": plan.EntityTable.ValueString(),
+		"entity_id":    plan.EntityID.ValueInt64(),
+		"source":       source,
+	})
+
+	info, err := os.Stat(source)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading attachment source",
+			"Could not read "+source+": "+err.Error(),
+		)
+		return
+	}
+
+	if info.Size() > maxAttachmentSize {
+		resp.Diagnostics.AddError(
+			"Attachment too large",
+			fmt.Sprintf("%s is %d bytes, the maximum attachment size is %d bytes.", source, info.Size(), maxAttachmentSize),
+		)
+		return
+	}
+
+	content, err := os.ReadFile(source)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading attachment source",
+			"Could not read "+source+": "+err.Error(),
+		)
+		return
+	}
+
+	// Call API
+	result, err := r.client.CreateAttachment(ctx, plan.EntityTable.ValueString(), plan.EntityID.ValueInt64(), plan.Name.ValueString(), content)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating attachment",
+			"Could not create attachment, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update state with response
+	r.mapResponseToModel(result, &plan)
+
+	tflog.Debug(ctx, "Created attachment", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *AttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AttachmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading attachment", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	result, err := r.client.GetAttachment(ctx, state.ID.ValueInt64())
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Attachment no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading attachment",
+			"Could not read attachment ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	r.mapResponseToModel(result, &state)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only stores the plan: every configurable attribute forces replacement,
+// except for setting source and source_hash after an import.
+func (r *AttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AttachmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *AttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AttachmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting attachment", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	err := r.client.DeleteAttachment(ctx, state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting attachment",
+			"Could not delete attachment ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted attachment", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+}
+
+func (r *AttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Could not parse import ID as integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// mapResponseToModel maps an Attachment API result. The name is only taken
+// from the response when unset, since CiviCRM may store a munged file name.
+func (r *AttachmentResource) mapResponseToModel(result map[string]any, model *AttachmentResourceModel) {
+	if id, ok := GetInt64(result, "id"); ok {
+		model.ID = types.Int64Value(id)
+	}
+
+	if entityTable, ok := GetString(result, "entity_table"); ok {
+		model.EntityTable = types.StringValue(entityTable)
+	}
+
+	if entityID, ok := GetInt64(result, "entity_id"); ok {
+		model.EntityID = types.Int64Value(entityID)
+	}
+
+	if name, ok := GetString(result, "name"); ok && model.Name.IsNull() {
+		model.Name = types.StringValue(name)
+	}
+
+	if mimeType, ok := GetString(result, "mime_type"); ok {
+		model.MimeType = types.StringValue(mimeType)
+	}
+
+	if url, ok := GetString(result, "url"); ok {
+		model.URL = types.StringValue(url)
+	}
+}
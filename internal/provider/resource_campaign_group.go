@@ -0,0 +1,356 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &CampaignGroupResource{}
+	_ resource.ResourceWithConfigure   = &CampaignGroupResource{}
+	_ resource.ResourceWithImportState = &CampaignGroupResource{}
+)
+
+// CampaignGroupResource manages the groups a campaign targets.
+type CampaignGroupResource struct {
+	client *Client
+}
+
+type CampaignGroupResourceModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	CampaignID  types.Int64  `tfsdk:"campaign_id"`
+	GroupType   types.String `tfsdk:"group_type"`
+	EntityTable types.String `tfsdk:"entity_table"`
+	EntityID    types.Int64  `tfsdk:"entity_id"`
+}
+
+// campaignGroupSelect selects the CampaignGroup fields that
+// mapResponseToModel reads.
+var campaignGroupSelect = []string{"id", "campaign_id", "group_type", "entity_table", "entity_id"}
+
+func NewCampaignGroupResource() resource.Resource {
+	return &CampaignGroupResource{}
+}
+
+func (r *CampaignGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_campaign_group"
+}
+
+func (r *CampaignGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Includes a group in or excludes it from the audience of a CiviCRM Campaign.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier of the campaign group link.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"campaign_id": schema.Int64Attribute{
+				Description: "The ID of the campaign.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"group_type": schema.StringAttribute{
+				Description: "Whether the contacts of the group are included in or excluded from the campaign. Options: 'Include', 'Exclude'.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Include", "Exclude"),
+				},
+			},
+			"entity_table": schema.StringAttribute{
+				Description: "The type of the linked entity. Default: 'civicrm_group'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("civicrm_group"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_id": schema.Int64Attribute{
+				Description: "The ID of the linked entity, e.g. the group ID.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CampaignGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CampaignGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan CampaignGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating campaign group", map[string]any{
+		"campaign_id": plan.CampaignID.ValueInt64(),
+		"entity_id":   plan.EntityID.ValueInt64(),
+	})
+
+	// Build values for API call
+	values := map[string]any{
+		"campaign_id":  plan.CampaignID.ValueInt64(),
+		"group_type":   plan.GroupType.ValueString(),
+		"entity_table": plan.EntityTable.ValueString(),
+		"entity_id":    plan.EntityID.ValueInt64(),
+	}
+
+	// Call API
+	result, err := r.client.Create(ctx, "CampaignGroup", values)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating campaign group",
+			"Could not create campaign group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update state with response
+	r.mapResponseToModel(result, &plan)
+
+	tflog.Debug(ctx, "Created campaign group", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *CampaignGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state CampaignGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading campaign group", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	result, err := r.client.GetByID(ctx, "CampaignGroup", state.ID.ValueInt64(), campaignGroupSelect)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Campaign group no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading campaign group",
+			"Could not read campaign group ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	r.mapResponseToModel(result, &state)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *CampaignGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan CampaignGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state CampaignGroupResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating campaign group", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	// Only group_type can change in place
+	values := map[string]any{
+		"group_type": plan.GroupType.ValueString(),
+	}
+
+	// Call API
+	result, err := r.client.Update(ctx, "CampaignGroup", state.ID.ValueInt64(), values)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating campaign group",
+			"Could not update campaign group ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	plan.ID = state.ID
+	r.mapResponseToModel(result, &plan)
+
+	tflog.Debug(ctx, "Updated campaign group", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *CampaignGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state CampaignGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting campaign group", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	err := r.client.Delete(ctx, "CampaignGroup", state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting campaign group",
+			"Could not delete campaign group ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted campaign group", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+}
+
+// ImportState accepts an import ID of the form "campaign_id/group_type/entity_id".
+func (r *CampaignGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Expected import ID in the format 'campaign_id/group_type/entity_id', got: "+req.ID,
+		)
+		return
+	}
+
+	campaignID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Could not parse campaign_id as integer: "+err.Error(),
+		)
+		return
+	}
+
+	groupType := parts[1]
+	if groupType != "Include" && groupType != "Exclude" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Expected group_type 'Include' or 'Exclude', got: "+groupType,
+		)
+		return
+	}
+
+	entityID, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Could not parse entity_id as integer: "+err.Error(),
+		)
+		return
+	}
+
+	results, err := r.client.Get(ctx, "CampaignGroup", [][]any{
+		{"campaign_id", "=", campaignID},
+		{"group_type", "=", groupType},
+		{"entity_id", "=", entityID},
+	}, []string{"id"})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing campaign group",
+			"Could not look up campaign group: "+err.Error(),
+		)
+		return
+	}
+
+	if len(results) == 0 {
+		resp.Diagnostics.AddError(
+			"Campaign group not found",
+			fmt.Sprintf("No %s group link found for campaign_id %d and entity_id %d.", groupType, campaignID, entityID),
+		)
+		return
+	}
+
+	id, ok := GetInt64(results[0], "id")
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing campaign group",
+			"Campaign group lookup returned no valid id.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (r *CampaignGroupResource) mapResponseToModel(result map[string]any, model *CampaignGroupResourceModel) {
+	if id, ok := GetInt64(result, "id"); ok {
+		model.ID = types.Int64Value(id)
+	}
+
+	if campaignID, ok := GetInt64(result, "campaign_id"); ok {
+		model.CampaignID = types.Int64Value(campaignID)
+	}
+
+	if groupType, ok := GetString(result, "group_type"); ok {
+		model.GroupType = types.StringValue(groupType)
+	}
+
+	if entityTable, ok := GetString(result, "entity_table"); ok {
+		model.EntityTable = types.StringValue(entityTable)
+	}
+
+	if entityID, ok := GetInt64(result, "entity_id"); ok {
+		model.EntityID = types.Int64Value(entityID)
+	}
+}
@@ -2,8 +2,10 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -11,14 +13,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource                = &ContactTypeResource{}
-	_ resource.ResourceWithConfigure   = &ContactTypeResource{}
-	_ resource.ResourceWithImportState = &ContactTypeResource{}
+	_ resource.Resource                   = &ContactTypeResource{}
+	_ resource.ResourceWithConfigure      = &ContactTypeResource{}
+	_ resource.ResourceWithImportState    = &ContactTypeResource{}
+	_ resource.ResourceWithModifyPlan     = &ContactTypeResource{}
+	_ resource.ResourceWithValidateConfig = &ContactTypeResource{}
 )
 
 // ContactTypeResource manages contact types in CiviCRM.
@@ -27,17 +32,30 @@ type ContactTypeResource struct {
 }
 
 type ContactTypeResourceModel struct {
-	ID          types.Int64  `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Label       types.String `tfsdk:"label"`
-	Description types.String `tfsdk:"description"`
-	ImageURL    types.String `tfsdk:"image_url"`
-	Icon        types.String `tfsdk:"icon"`
-	ParentID    types.Int64  `tfsdk:"parent_id"`
-	IsActive    types.Bool   `tfsdk:"is_active"`
-	IsReserved  types.Bool   `tfsdk:"is_reserved"`
+	ID                   types.Int64  `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Label                types.String `tfsdk:"label"`
+	Description          types.String `tfsdk:"description"`
+	ImageURL             types.String `tfsdk:"image_url"`
+	Icon                 types.String `tfsdk:"icon"`
+	ParentID             types.Int64  `tfsdk:"parent_id"`
+	IsActive             types.Bool   `tfsdk:"is_active"`
+	IsReserved           types.Bool   `tfsdk:"is_reserved"`
+	AllowReservedChanges types.Bool   `tfsdk:"allow_reserved_changes"`
+	IgnoreFields         types.List   `tfsdk:"ignore_fields"`
 }
 
+// contactTypeSelect selects the ContactType fields that mapResponseToModel
+// reads.
+var contactTypeSelect = []string{
+	"id", "name", "label", "description", "image_URL", "icon", "parent_id", "is_active",
+	"is_reserved",
+}
+
+// contactTypeAPINames maps the attributes whose ContactType field has a
+// different name, see omitIgnoredFields.
+var contactTypeAPINames = map[string][]string{"image_url": {"image_URL"}}
+
 func NewContactTypeResource() resource.Resource {
 	return &ContactTypeResource{}
 }
@@ -58,8 +76,11 @@ func (r *ContactTypeResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "The machine name of the contact type (must be unique).",
+				Description: "The machine name of the contact type (must be unique). Changing this forces a new contact type to be created.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"label": schema.StringAttribute{
 				Description: "The display label of the contact type.",
@@ -93,6 +114,8 @@ func (r *ContactTypeResource) Schema(ctx context.Context, req resource.SchemaReq
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
 			},
+			"allow_reserved_changes": allowReservedChangesAttribute,
+			"ignore_fields":          ignoreFieldsAttribute,
 		},
 	}
 }
@@ -114,6 +137,17 @@ func (r *ContactTypeResource) Configure(ctx context.Context, req resource.Config
 	r.client = client
 }
 
+// ValidateConfig rejects ignore_fields entries that name no attribute or one
+// that cannot be ignored.
+func (r *ContactTypeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateIgnoredFields(ctx, req.Config, &resp.Diagnostics)
+}
+
+// ModifyPlan protects reserved contact types from accidental changes.
+func (r *ContactTypeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkReservedChange(ctx, req, resp, "contact type")
+}
+
 func (r *ContactTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan ContactTypeResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -147,11 +181,19 @@ func (r *ContactTypeResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	if !plan.ParentID.IsNull() {
+		if err := r.validateParent(ctx, 0, plan.ParentID.ValueInt64()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("parent_id"),
+				"Invalid parent contact type",
+				err.Error(),
+			)
+			return
+		}
 		values["parent_id"] = plan.ParentID.ValueInt64()
 	}
 
 	// Call API
-	result, err := r.client.Create("ContactType", values)
+	result, err := r.client.Create(ctx, "ContactType", values)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating contact type",
@@ -183,7 +225,14 @@ func (r *ContactTypeResource) Read(ctx context.Context, req resource.ReadRequest
 		"id": state.ID.ValueInt64(),
 	})
 
-	result, err := r.client.GetByID("ContactType", state.ID.ValueInt64(), nil)
+	result, err := r.client.GetByID(ctx, "ContactType", state.ID.ValueInt64(), contactTypeSelect)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Contact type no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading contact type",
@@ -197,6 +246,10 @@ func (r *ContactTypeResource) Read(ctx context.Context, req resource.ReadRequest
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+
+	// Keep the prior value of attributes managed outside of Terraform
+	ignored := ignoredFields(ctx, state.IgnoreFields, &resp.Diagnostics)
+	keepIgnoredFields(ctx, ignored, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ContactTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -245,13 +298,27 @@ func (r *ContactTypeResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	if !plan.ParentID.IsNull() {
+		if err := r.validateParent(ctx, state.ID.ValueInt64(), plan.ParentID.ValueInt64()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("parent_id"),
+				"Invalid parent contact type",
+				err.Error(),
+			)
+			return
+		}
 		values["parent_id"] = plan.ParentID.ValueInt64()
 	} else {
 		values["parent_id"] = nil
 	}
 
+	ignored := ignoredFields(ctx, plan.IgnoreFields, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	omitIgnoredFields(values, ignored, contactTypeAPINames)
+
 	// Call API
-	result, err := r.client.Update("ContactType", state.ID.ValueInt64(), values)
+	result, err := r.client.Update(ctx, "ContactType", state.ID.ValueInt64(), values)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating contact type",
@@ -270,6 +337,8 @@ func (r *ContactTypeResource) Update(ctx context.Context, req resource.UpdateReq
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	keepIgnoredFields(ctx, ignored, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ContactTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -284,7 +353,28 @@ func (r *ContactTypeResource) Delete(ctx context.Context, req resource.DeleteReq
 		"id": state.ID.ValueInt64(),
 	})
 
-	err := r.client.Delete("ContactType", state.ID.ValueInt64())
+	if !state.ParentID.IsNull() {
+		titles, err := r.customGroupsExtendingSubtype(ctx, state.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting contact type",
+				"Could not check custom groups of contact type ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+			)
+			return
+		}
+
+		if len(titles) > 0 {
+			resp.Diagnostics.AddError(
+				"Contact type has custom data",
+				fmt.Sprintf("The custom groups %s extend contact subtype %q. Deleting the subtype would orphan their data. "+
+					"Remove the subtype from these custom groups or delete them first.",
+					strings.Join(titles, ", "), state.Name.ValueString()),
+			)
+			return
+		}
+	}
+
+	err := r.client.Delete(ctx, "ContactType", state.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting contact type",
@@ -309,6 +399,63 @@ func (r *ContactTypeResource) ImportState(ctx context.Context, req resource.Impo
 	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("allow_reserved_changes"), false)...)
+}
+
+// validateParent checks that parentID can be the parent of the contact type
+// with the given ID (0 for a new contact type). CiviCRM only supports one level
+// of subtypes, so the parent must be a base contact type and a contact type
+// that has subtypes of its own cannot become a subtype.
+func (r *ContactTypeResource) validateParent(ctx context.Context, id, parentID int64) error {
+	if id != 0 && id == parentID {
+		return fmt.Errorf("a contact type cannot be its own parent")
+	}
+
+	parent, err := r.client.GetByID(ctx, "ContactType", parentID, []string{"id", "name", "parent_id"})
+	if err != nil {
+		return fmt.Errorf("could not look up parent contact type %d: %w", parentID, err)
+	}
+
+	if _, ok := GetInt64(parent, "parent_id"); ok {
+		name, _ := GetString(parent, "name")
+		return fmt.Errorf("contact type %d (%s) is itself a subtype; only base contact types such as Individual, Household or Organization can be parents", parentID, name)
+	}
+
+	if id == 0 {
+		return nil
+	}
+
+	children, err := r.client.Get(ctx, "ContactType", [][]any{
+		{"parent_id", "=", id},
+	}, []string{"id"})
+	if err != nil {
+		return fmt.Errorf("could not look up subtypes of contact type %d: %w", id, err)
+	}
+
+	if len(children) > 0 {
+		return fmt.Errorf("contact type %d has %d subtype(s) and cannot become a subtype itself", id, len(children))
+	}
+
+	return nil
+}
+
+// customGroupsExtendingSubtype returns the titles of the custom groups that
+// are limited to the contact subtype with the given name.
+func (r *ContactTypeResource) customGroupsExtendingSubtype(ctx context.Context, name string) ([]string, error) {
+	groups, err := r.client.Get(ctx, "CustomGroup", [][]any{
+		{"extends_entity_column_value", "CONTAINS", name},
+	}, []string{"id", "title"})
+	if err != nil {
+		return nil, err
+	}
+
+	titles := make([]string, 0, len(groups))
+	for _, group := range groups {
+		title, _ := GetString(group, "title")
+		titles = append(titles, strconv.Quote(title))
+	}
+
+	return titles, nil
 }
 
 func (r *ContactTypeResource) mapResponseToModel(result map[string]any, model *ContactTypeResourceModel) {
@@ -324,11 +471,7 @@ func (r *ContactTypeResource) mapResponseToModel(result map[string]any, model *C
 		model.Label = types.StringValue(label)
 	}
 
-	if description, ok := GetString(result, "description"); ok && description != "" {
-		model.Description = types.StringValue(description)
-	} else {
-		model.Description = types.StringNull()
-	}
+	model.Description = r.client.optionalString(result, "description", model.Description)
 
 	if imageURL, ok := GetString(result, "image_URL"); ok && imageURL != "" {
 		model.ImageURL = types.StringValue(imageURL)
@@ -336,11 +479,7 @@ func (r *ContactTypeResource) mapResponseToModel(result map[string]any, model *C
 		model.ImageURL = types.StringNull()
 	}
 
-	if icon, ok := GetString(result, "icon"); ok && icon != "" {
-		model.Icon = types.StringValue(icon)
-	} else {
-		model.Icon = types.StringNull()
-	}
+	model.Icon = r.client.optionalString(result, "icon", model.Icon)
 
 	if parentID, ok := GetInt64(result, "parent_id"); ok {
 		model.ParentID = types.Int64Value(parentID)
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestContactTypeResourceNameRequiresReplace(t *testing.T) {
+	r := &ContactTypeResource{}
+	s := newTestResource(t, r, nil)
+
+	attribute, ok := s.Attributes["name"].(schema.StringAttribute)
+	if !ok {
+		t.Fatalf("name is a %T, want schema.StringAttribute", s.Attributes["name"])
+	}
+
+	state := ContactTypeResourceModel{
+		ID:                   types.Int64Value(9),
+		Name:                 types.StringValue("Volunteer"),
+		Label:                types.StringValue("Volunteer"),
+		Description:          types.StringNull(),
+		ImageURL:             types.StringNull(),
+		Icon:                 types.StringNull(),
+		ParentID:             types.Int64Value(1),
+		IsActive:             types.BoolValue(true),
+		IsReserved:           types.BoolValue(false),
+		AllowReservedChanges: types.BoolValue(false),
+		IgnoreFields:         types.ListNull(types.StringType),
+	}
+
+	tests := []struct {
+		name        string
+		state       tfsdk.State
+		planName    string
+		planLabel   string
+		wantReplace bool
+	}{
+		{
+			name:        "name changed",
+			state:       testState(t, s, state),
+			planName:    "Helper",
+			planLabel:   "Volunteer",
+			wantReplace: true,
+		},
+		{
+			name:      "label changed",
+			state:     testState(t, s, state),
+			planName:  "Volunteer",
+			planLabel: "Helper",
+		},
+		{
+			name:      "create",
+			state:     emptyTestState(s),
+			planName:  "Helper",
+			planLabel: "Helper",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			plan := state
+			plan.Name = types.StringValue(tt.planName)
+			plan.Label = types.StringValue(tt.planLabel)
+
+			stateName := types.StringNull()
+			if !tt.state.Raw.IsNull() {
+				stateName = state.Name
+			}
+
+			req := planmodifier.StringRequest{
+				Path:        path.Root("name"),
+				Config:      testConfig(t, s, plan),
+				Plan:        testPlan(t, s, plan),
+				State:       tt.state,
+				ConfigValue: plan.Name,
+				PlanValue:   plan.Name,
+				StateValue:  stateName,
+			}
+			resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+			for _, modifier := range attribute.PlanModifiers {
+				modifier.PlanModifyString(ctx, req, resp)
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("PlanModifyString: %v", resp.Diagnostics)
+			}
+			if resp.RequiresReplace != tt.wantReplace {
+				t.Errorf("RequiresReplace = %t, want %t", resp.RequiresReplace, tt.wantReplace)
+			}
+		})
+	}
+}
+
+func TestContactTypeResourceDelete(t *testing.T) {
+	tests := []struct {
+		name         string
+		parentID     types.Int64
+		customGroups []map[string]any
+		wantChecked  bool
+		wantDeleted  bool
+	}{
+		{
+			name:         "subtype with custom groups",
+			parentID:     types.Int64Value(1),
+			customGroups: []map[string]any{{"id": 5, "title": "Volunteer Skills"}},
+			wantChecked:  true,
+		},
+		{
+			name:        "subtype without custom groups",
+			parentID:    types.Int64Value(1),
+			wantChecked: true,
+			wantDeleted: true,
+		},
+		{
+			name:        "base type",
+			parentID:    types.Int64Null(),
+			wantDeleted: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var checked, deleted bool
+			client := newTestClient(t, func(req testRequest) ([]map[string]any, error) {
+				switch req.Entity + "." + req.Action {
+				case "CustomGroup.get":
+					checked = true
+					where, _ := json.Marshal(req.Params["where"])
+					if want := `[["extends_entity_column_value","CONTAINS","Volunteer"]]`; string(where) != want {
+						t.Errorf("CustomGroup.get where = %s, want %s", where, want)
+					}
+					return tt.customGroups, nil
+				case "ContactType.delete":
+					deleted = true
+					return nil, nil
+				}
+				t.Errorf("unexpected request %s.%s", req.Entity, req.Action)
+				return nil, errors.New("unexpected request")
+			})
+
+			r := &ContactTypeResource{}
+			s := newTestResource(t, r, client)
+
+			state := ContactTypeResourceModel{
+				ID:                   types.Int64Value(9),
+				Name:                 types.StringValue("Volunteer"),
+				Label:                types.StringValue("Volunteer"),
+				Description:          types.StringNull(),
+				ImageURL:             types.StringNull(),
+				Icon:                 types.StringNull(),
+				ParentID:             tt.parentID,
+				IsActive:             types.BoolValue(true),
+				IsReserved:           types.BoolValue(false),
+				AllowReservedChanges: types.BoolValue(false),
+				IgnoreFields:         types.ListNull(types.StringType),
+			}
+
+			resp := &resource.DeleteResponse{State: testState(t, s, state)}
+			r.Delete(context.Background(), resource.DeleteRequest{State: testState(t, s, state)}, resp)
+
+			if checked != tt.wantChecked {
+				t.Errorf("checked custom groups = %t, want %t", checked, tt.wantChecked)
+			}
+			if deleted != tt.wantDeleted {
+				t.Errorf("deleted = %t, want %t", deleted, tt.wantDeleted)
+			}
+			wantErr := !tt.wantDeleted
+			if got := resp.Diagnostics.HasError(); got != wantErr {
+				t.Errorf("error = %t, want %t: %v", got, wantErr, resp.Diagnostics)
+			}
+			if wantErr && !strings.Contains(fmt.Sprint(resp.Diagnostics), `"Volunteer Skills"`) {
+				t.Errorf("diagnostics do not name the custom group: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
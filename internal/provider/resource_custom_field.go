@@ -2,9 +2,14 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -14,16 +19,59 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource                = &CustomFieldResource{}
-	_ resource.ResourceWithConfigure   = &CustomFieldResource{}
-	_ resource.ResourceWithImportState = &CustomFieldResource{}
+	_ resource.Resource                   = &CustomFieldResource{}
+	_ resource.ResourceWithConfigure      = &CustomFieldResource{}
+	_ resource.ResourceWithImportState    = &CustomFieldResource{}
+	_ resource.ResourceWithValidateConfig = &CustomFieldResource{}
 )
 
+// serializableHtmlTypes lists the html types that can hold multiple values and
+// therefore support serialize = 1.
+var serializableHtmlTypes = []string{
+	"Select",
+	"Multi-Select",
+	"AdvMulti-Select",
+	"CheckBox",
+	"Autocomplete-Select",
+	"EntityRef",
+}
+
+// textLengthDataTypes lists the data types CiviCRM stores text_length for.
+var textLengthDataTypes = []string{"String"}
+
+// searchRangeDataTypes lists the data types CiviCRM can search by range.
+var searchRangeDataTypes = []string{"Int", "Float", "Money", "Date"}
+
+// customFieldDateFormats lists the date formats CiviCRM offers for Date fields,
+// in the date picker notation it stores them in.
+var customFieldDateFormats = []string{
+	"mm/dd/yy",
+	"dd/mm/yy",
+	"yy-mm-dd",
+	"dd-mm-yy",
+	"dd.mm.yy",
+	"M d, yy",
+	"d M yy",
+	"MM d, yy",
+	"d MM yy",
+	"DD, d MM yy",
+	"mm/dd",
+	"dd-mm",
+	"yy-mm",
+	"M yy",
+	"yy",
+}
+
+// noteSizeHtmlTypes lists the html types CiviCRM stores note_columns and
+// note_rows for.
+var noteSizeHtmlTypes = []string{"TextArea", "RichTextEditor"}
+
 // CustomFieldResource manages custom fields in CiviCRM.
 type CustomFieldResource struct {
 	client *Client
@@ -61,6 +109,18 @@ type CustomFieldResourceModel struct {
 	InSelector       types.Bool   `tfsdk:"in_selector"`
 	FkEntity         types.String `tfsdk:"fk_entity"`
 	FkEntityOnDelete types.String `tfsdk:"fk_entity_on_delete"`
+	IgnoreFields     types.List   `tfsdk:"ignore_fields"`
+}
+
+// customFieldSelect selects the CustomField fields that mapResponseToModel
+// reads.
+var customFieldSelect = []string{
+	"id", "custom_group_id", "name", "label", "data_type", "html_type", "default_value",
+	"is_required", "is_searchable", "is_search_range", "weight", "help_pre", "help_post",
+	"attributes", "is_active", "is_view", "options_per_line", "text_length",
+	"start_date_years", "end_date_years", "date_format", "time_format", "note_columns",
+	"note_rows", "column_name", "option_group_id", "serialize", "filter", "in_selector",
+	"fk_entity", "fk_entity_on_delete",
 }
 
 func NewCustomFieldResource() resource.Resource {
@@ -119,17 +179,12 @@ func (r *CustomFieldResource) Schema(ctx context.Context, req resource.SchemaReq
 				Default:     booldefault.StaticBool(false),
 			},
 			"is_search_range": schema.BoolAttribute{
-				Description: "Whether to enable range search for this field. Default: false.",
+				Description: "Whether to enable range search for this field. Requires is_searchable = true and a data_type of 'Int', 'Float', 'Money' or 'Date'. Default: false.",
 				Optional:    true,
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
 			},
-			"weight": schema.Int64Attribute{
-				Description: "The display order weight. Default: 1.",
-				Optional:    true,
-				Computed:    true,
-				Default:     int64default.StaticInt64(1),
-			},
+			"weight": managedWeightAttribute("custom field"),
 			"help_pre": schema.StringAttribute{
 				Description: "Help text displayed before the field.",
 				Optional:    true,
@@ -159,10 +214,12 @@ func (r *CustomFieldResource) Schema(ctx context.Context, req resource.SchemaReq
 				Optional:    true,
 			},
 			"text_length": schema.Int64Attribute{
-				Description: "Maximum text length for text fields. Default: 255.",
+				Description: "Maximum text length. Only used for 'String' fields, where CiviCRM defaults it to 255.",
 				Optional:    true,
 				Computed:    true,
-				Default:     int64default.StaticInt64(255),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"start_date_years": schema.Int64Attribute{
 				Description: "Number of years before current date for date picker start.",
@@ -173,24 +230,31 @@ func (r *CustomFieldResource) Schema(ctx context.Context, req resource.SchemaReq
 				Optional:    true,
 			},
 			"date_format": schema.StringAttribute{
-				Description: "The date format string.",
+				Description: "The date format of Date fields in date picker notation (e.g., 'mm/dd/yy', 'yy-mm-dd', 'd MM yy').",
 				Optional:    true,
 			},
 			"time_format": schema.Int64Attribute{
-				Description: "The time format (1 for 12-hour, 2 for 24-hour).",
+				Description: "The time format of Date fields that include a time (1 for 12-hour, 2 for 24-hour).",
 				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(1, 2),
+				},
 			},
 			"note_columns": schema.Int64Attribute{
-				Description: "Number of columns for note/textarea fields. Default: 60.",
+				Description: "Number of columns. Only used for 'TextArea' and 'RichTextEditor' fields, where CiviCRM defaults it to 60.",
 				Optional:    true,
 				Computed:    true,
-				Default:     int64default.StaticInt64(60),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"note_rows": schema.Int64Attribute{
-				Description: "Number of rows for note/textarea fields. Default: 4.",
+				Description: "Number of rows. Only used for 'TextArea' and 'RichTextEditor' fields, where CiviCRM defaults it to 4.",
 				Optional:    true,
 				Computed:    true,
-				Default:     int64default.StaticInt64(4),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"column_name": schema.StringAttribute{
 				Description: "The database column name. Auto-generated if not specified.",
@@ -205,10 +269,13 @@ func (r *CustomFieldResource) Schema(ctx context.Context, req resource.SchemaReq
 				Optional:    true,
 			},
 			"serialize": schema.Int64Attribute{
-				Description: "Serialization method (0 for none, 1 for separator). Default: 0.",
+				Description: "Serialization method (0 for none, 1 for separator). Only multi-value html types support 1. Default: 0.",
 				Optional:    true,
 				Computed:    true,
 				Default:     int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.OneOf(0, 1),
+				},
 			},
 			"filter": schema.StringAttribute{
 				Description: "Filter for entity reference fields.",
@@ -230,10 +297,137 @@ func (r *CustomFieldResource) Schema(ctx context.Context, req resource.SchemaReq
 				Computed:    true,
 				Default:     stringdefault.StaticString("set_null"),
 			},
+			"ignore_fields": ignoreFieldsAttribute,
 		},
 	}
 }
 
+// ValidateConfig rejects serialize = 1 for html types that hold a single value,
+// which would otherwise produce a field that stores its data incorrectly. It
+// also rejects size attributes that CiviCRM does not store for the field type,
+// and range search on fields that are not searchable or not numeric or dates,
+// as well as ignore_fields entries that name no attribute or one that cannot be
+// ignored.
+func (r *CustomFieldResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateIgnoredFields(ctx, req.Config, &resp.Diagnostics)
+
+	var config CustomFieldResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.DataType.IsUnknown() && !config.TextLength.IsNull() && !slices.Contains(textLengthDataTypes, config.DataType.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("text_length"),
+			"Unsupported text_length",
+			fmt.Sprintf("text_length is only stored for the data types %s, got: %s.",
+				strings.Join(textLengthDataTypes, ", "), config.DataType.ValueString()),
+		)
+	}
+
+	if !config.HtmlType.IsUnknown() && !slices.Contains(noteSizeHtmlTypes, config.HtmlType.ValueString()) {
+		sizes := []struct {
+			name  string
+			value types.Int64
+		}{
+			{"note_columns", config.NoteColumns},
+			{"note_rows", config.NoteRows},
+		}
+		for _, size := range sizes {
+			if size.value.IsNull() {
+				continue
+			}
+			name := size.name
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Unsupported "+name,
+				fmt.Sprintf("%s is only stored for the html types %s, got: %s.",
+					name, strings.Join(noteSizeHtmlTypes, ", "), config.HtmlType.ValueString()),
+			)
+		}
+	}
+
+	if !config.DataType.IsUnknown() && config.DataType.ValueString() != "Date" {
+		formats := []struct {
+			name  string
+			isSet bool
+		}{
+			{"date_format", !config.DateFormat.IsNull()},
+			{"time_format", !config.TimeFormat.IsNull()},
+		}
+		for _, format := range formats {
+			if !format.isSet {
+				continue
+			}
+			name := format.name
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root(name),
+				"Unused "+name,
+				name+" only applies to fields with data_type 'Date' and is ignored for "+config.DataType.ValueString()+".",
+			)
+		}
+	}
+
+	if !config.DateFormat.IsNull() && !config.DateFormat.IsUnknown() && !slices.Contains(customFieldDateFormats, config.DateFormat.ValueString()) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("date_format"),
+			"Unrecognized date_format",
+			fmt.Sprintf("%q is not one of the date formats CiviCRM offers (%s). Dates may be displayed incorrectly.",
+				config.DateFormat.ValueString(), strings.Join(customFieldDateFormats, ", ")),
+		)
+	}
+
+	if config.IsSearchRange.ValueBool() {
+		if !config.IsSearchable.IsUnknown() && !config.IsSearchable.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("is_search_range"),
+				"Invalid is_search_range",
+				"is_search_range requires is_searchable = true; CiviCRM ignores range search on fields that are not searchable.",
+			)
+		}
+		if !config.DataType.IsUnknown() && !slices.Contains(searchRangeDataTypes, config.DataType.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("is_search_range"),
+				"Invalid is_search_range",
+				fmt.Sprintf("is_search_range is only supported for the data types %s, got: %s.",
+					strings.Join(searchRangeDataTypes, ", "), config.DataType.ValueString()),
+			)
+		}
+	}
+
+	if config.Serialize.IsNull() || config.Serialize.IsUnknown() || config.HtmlType.IsUnknown() {
+		return
+	}
+
+	if config.Serialize.ValueInt64() == 1 && !slices.Contains(serializableHtmlTypes, config.HtmlType.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("serialize"),
+			"Invalid serialize value",
+			fmt.Sprintf("serialize = 1 is only supported for the html types %s, got: %s.",
+				strings.Join(serializableHtmlTypes, ", "), config.HtmlType.ValueString()),
+		)
+	}
+}
+
+// setSizeValues adds text_length, note_columns and note_rows to values when
+// they are known and apply to the field's type. CiviCRM returns them as null
+// for other types, and fills in its own defaults when they are left out.
+func setSizeValues(plan CustomFieldResourceModel, values map[string]any) {
+	if slices.Contains(textLengthDataTypes, plan.DataType.ValueString()) && !plan.TextLength.IsNull() && !plan.TextLength.IsUnknown() {
+		values["text_length"] = plan.TextLength.ValueInt64()
+	}
+
+	if slices.Contains(noteSizeHtmlTypes, plan.HtmlType.ValueString()) {
+		if !plan.NoteColumns.IsNull() && !plan.NoteColumns.IsUnknown() {
+			values["note_columns"] = plan.NoteColumns.ValueInt64()
+		}
+		if !plan.NoteRows.IsNull() && !plan.NoteRows.IsUnknown() {
+			values["note_rows"] = plan.NoteRows.ValueInt64()
+		}
+	}
+}
+
 func (r *CustomFieldResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -274,17 +468,19 @@ func (r *CustomFieldResource) Create(ctx context.Context, req resource.CreateReq
 		"is_required":         plan.IsRequired.ValueBool(),
 		"is_searchable":       plan.IsSearchable.ValueBool(),
 		"is_search_range":     plan.IsSearchRange.ValueBool(),
-		"weight":              plan.Weight.ValueInt64(),
 		"is_active":           plan.IsActive.ValueBool(),
 		"is_view":             plan.IsView.ValueBool(),
-		"text_length":         plan.TextLength.ValueInt64(),
-		"note_columns":        plan.NoteColumns.ValueInt64(),
-		"note_rows":           plan.NoteRows.ValueInt64(),
 		"serialize":           plan.Serialize.ValueInt64(),
 		"in_selector":         plan.InSelector.ValueBool(),
 		"fk_entity_on_delete": plan.FkEntityOnDelete.ValueString(),
 	}
 
+	if weight, ok := configuredWeight(ctx, req.Config, &resp.Diagnostics); ok {
+		values["weight"] = weight
+	}
+
+	setSizeValues(plan, values)
+
 	if !plan.DefaultValue.IsNull() {
 		values["default_value"] = plan.DefaultValue.ValueString()
 	}
@@ -338,7 +534,7 @@ func (r *CustomFieldResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// Call API
-	result, err := r.client.Create("CustomField", values)
+	result, err := createWithTableRetry(ctx, r.client, values)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating custom field",
@@ -370,7 +566,14 @@ func (r *CustomFieldResource) Read(ctx context.Context, req resource.ReadRequest
 		"id": state.ID.ValueInt64(),
 	})
 
-	result, err := r.client.GetByID("CustomField", state.ID.ValueInt64(), nil)
+	result, err := r.client.GetByID(ctx, "CustomField", state.ID.ValueInt64(), customFieldSelect)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Custom field no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading custom field",
@@ -384,6 +587,10 @@ func (r *CustomFieldResource) Read(ctx context.Context, req resource.ReadRequest
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+
+	// Keep the prior value of attributes managed outside of Terraform
+	ignored := ignoredFields(ctx, state.IgnoreFields, &resp.Diagnostics)
+	keepIgnoredFields(ctx, ignored, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *CustomFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -415,17 +622,19 @@ func (r *CustomFieldResource) Update(ctx context.Context, req resource.UpdateReq
 		"is_required":         plan.IsRequired.ValueBool(),
 		"is_searchable":       plan.IsSearchable.ValueBool(),
 		"is_search_range":     plan.IsSearchRange.ValueBool(),
-		"weight":              plan.Weight.ValueInt64(),
 		"is_active":           plan.IsActive.ValueBool(),
 		"is_view":             plan.IsView.ValueBool(),
-		"text_length":         plan.TextLength.ValueInt64(),
-		"note_columns":        plan.NoteColumns.ValueInt64(),
-		"note_rows":           plan.NoteRows.ValueInt64(),
 		"serialize":           plan.Serialize.ValueInt64(),
 		"in_selector":         plan.InSelector.ValueBool(),
 		"fk_entity_on_delete": plan.FkEntityOnDelete.ValueString(),
 	}
 
+	if weight, ok := configuredWeight(ctx, req.Config, &resp.Diagnostics); ok {
+		values["weight"] = weight
+	}
+
+	setSizeValues(plan, values)
+
 	if !plan.DefaultValue.IsNull() {
 		values["default_value"] = plan.DefaultValue.ValueString()
 	} else {
@@ -498,8 +707,14 @@ func (r *CustomFieldResource) Update(ctx context.Context, req resource.UpdateReq
 		values["fk_entity"] = nil
 	}
 
+	ignored := ignoredFields(ctx, plan.IgnoreFields, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	omitIgnoredFields(values, ignored, nil)
+
 	// Call API
-	result, err := r.client.Update("CustomField", state.ID.ValueInt64(), values)
+	result, err := r.client.Update(ctx, "CustomField", state.ID.ValueInt64(), values)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating custom field",
@@ -518,6 +733,8 @@ func (r *CustomFieldResource) Update(ctx context.Context, req resource.UpdateReq
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	keepIgnoredFields(ctx, ignored, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *CustomFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -532,7 +749,7 @@ func (r *CustomFieldResource) Delete(ctx context.Context, req resource.DeleteReq
 		"id": state.ID.ValueInt64(),
 	})
 
-	err := r.client.Delete("CustomField", state.ID.ValueInt64())
+	err := r.client.Delete(ctx, "CustomField", state.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting custom field",
@@ -559,6 +776,45 @@ func (r *CustomFieldResource) ImportState(ctx context.Context, req resource.Impo
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
+// createWithTableRetry creates a custom field, retrying while the storage
+// table of a freshly created custom group is not available yet. CiviCRM
+// creates that table asynchronously for multi-record groups.
+func createWithTableRetry(ctx context.Context, client *Client, values map[string]any) (map[string]any, error) {
+	delay := customFieldTableRetryDelay
+	for attempt := 1; ; attempt++ {
+		result, err := client.Create(ctx, "CustomField", values)
+		if err == nil || attempt == customFieldTableRetries || !isMissingTableError(err) {
+			return result, err
+		}
+
+		tflog.Debug(ctx, "Custom group table not ready, retrying custom field create", map[string]any{
+			"attempt": attempt,
+			"delay":   delay.String(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+const customFieldTableRetries = 5
+
+// customFieldTableRetryDelay is the wait before the first retry of
+// createWithTableRetry. It is a variable so that tests can shorten it.
+var customFieldTableRetryDelay = 500 * time.Millisecond
+
+// isMissingTableError reports whether err is the database error CiviCRM returns
+// when the custom group table does not exist yet.
+func isMissingTableError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "table") &&
+		(strings.Contains(msg, "doesn't exist") || strings.Contains(msg, "does not exist"))
+}
+
 func (r *CustomFieldResource) mapResponseToModel(result map[string]any, model *CustomFieldResourceModel) {
 	if id, ok := GetInt64(result, "id"); ok {
 		model.ID = types.Int64Value(id)
@@ -584,11 +840,7 @@ func (r *CustomFieldResource) mapResponseToModel(result map[string]any, model *C
 		model.HtmlType = types.StringValue(htmlType)
 	}
 
-	if defaultValue, ok := GetString(result, "default_value"); ok && defaultValue != "" {
-		model.DefaultValue = types.StringValue(defaultValue)
-	} else {
-		model.DefaultValue = types.StringNull()
-	}
+	model.DefaultValue = r.client.optionalString(result, "default_value", model.DefaultValue)
 
 	if isRequired, ok := GetBool(result, "is_required"); ok {
 		model.IsRequired = types.BoolValue(isRequired)
@@ -604,25 +856,15 @@ func (r *CustomFieldResource) mapResponseToModel(result map[string]any, model *C
 
 	if weight, ok := GetInt64(result, "weight"); ok {
 		model.Weight = types.Int64Value(weight)
+	} else if model.Weight.IsUnknown() {
+		model.Weight = types.Int64Null()
 	}
 
-	if helpPre, ok := GetString(result, "help_pre"); ok && helpPre != "" {
-		model.HelpPre = types.StringValue(helpPre)
-	} else {
-		model.HelpPre = types.StringNull()
-	}
+	model.HelpPre = r.client.optionalString(result, "help_pre", model.HelpPre)
 
-	if helpPost, ok := GetString(result, "help_post"); ok && helpPost != "" {
-		model.HelpPost = types.StringValue(helpPost)
-	} else {
-		model.HelpPost = types.StringNull()
-	}
+	model.HelpPost = r.client.optionalString(result, "help_post", model.HelpPost)
 
-	if attributes, ok := GetString(result, "attributes"); ok && attributes != "" {
-		model.Attributes = types.StringValue(attributes)
-	} else {
-		model.Attributes = types.StringNull()
-	}
+	model.Attributes = r.client.optionalString(result, "attributes", model.Attributes)
 
 	if isActive, ok := GetBool(result, "is_active"); ok {
 		model.IsActive = types.BoolValue(isActive)
@@ -640,6 +882,8 @@ func (r *CustomFieldResource) mapResponseToModel(result map[string]any, model *C
 
 	if textLength, ok := GetInt64(result, "text_length"); ok {
 		model.TextLength = types.Int64Value(textLength)
+	} else {
+		model.TextLength = types.Int64Null()
 	}
 
 	if startDateYears, ok := GetInt64(result, "start_date_years"); ok {
@@ -654,11 +898,7 @@ func (r *CustomFieldResource) mapResponseToModel(result map[string]any, model *C
 		model.EndDateYears = types.Int64Null()
 	}
 
-	if dateFormat, ok := GetString(result, "date_format"); ok && dateFormat != "" {
-		model.DateFormat = types.StringValue(dateFormat)
-	} else {
-		model.DateFormat = types.StringNull()
-	}
+	model.DateFormat = r.client.optionalString(result, "date_format", model.DateFormat)
 
 	if timeFormat, ok := GetInt64(result, "time_format"); ok {
 		model.TimeFormat = types.Int64Value(timeFormat)
@@ -668,10 +908,14 @@ func (r *CustomFieldResource) mapResponseToModel(result map[string]any, model *C
 
 	if noteColumns, ok := GetInt64(result, "note_columns"); ok {
 		model.NoteColumns = types.Int64Value(noteColumns)
+	} else {
+		model.NoteColumns = types.Int64Null()
 	}
 
 	if noteRows, ok := GetInt64(result, "note_rows"); ok {
 		model.NoteRows = types.Int64Value(noteRows)
+	} else {
+		model.NoteRows = types.Int64Null()
 	}
 
 	if columnName, ok := GetString(result, "column_name"); ok {
@@ -688,21 +932,13 @@ func (r *CustomFieldResource) mapResponseToModel(result map[string]any, model *C
 		model.Serialize = types.Int64Value(serialize)
 	}
 
-	if filter, ok := GetString(result, "filter"); ok && filter != "" {
-		model.Filter = types.StringValue(filter)
-	} else {
-		model.Filter = types.StringNull()
-	}
+	model.Filter = r.client.optionalString(result, "filter", model.Filter)
 
 	if inSelector, ok := GetBool(result, "in_selector"); ok {
 		model.InSelector = types.BoolValue(inSelector)
 	}
 
-	if fkEntity, ok := GetString(result, "fk_entity"); ok && fkEntity != "" {
-		model.FkEntity = types.StringValue(fkEntity)
-	} else {
-		model.FkEntity = types.StringNull()
-	}
+	model.FkEntity = r.client.optionalString(result, "fk_entity", model.FkEntity)
 
 	if fkEntityOnDelete, ok := GetString(result, "fk_entity_on_delete"); ok {
 		model.FkEntityOnDelete = types.StringValue(fkEntityOnDelete)
@@ -0,0 +1,551 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testCustomFieldConfig returns the configuration of a String Text field with
+// every optional attribute left out.
+func testCustomFieldConfig() CustomFieldResourceModel {
+	return CustomFieldResourceModel{
+		ID:               types.Int64Null(),
+		CustomGroupID:    types.Int64Value(1),
+		Name:             types.StringValue("test_field"),
+		Label:            types.StringValue("Test Field"),
+		DataType:         types.StringValue("String"),
+		HtmlType:         types.StringValue("Text"),
+		DefaultValue:     types.StringNull(),
+		IsRequired:       types.BoolNull(),
+		IsSearchable:     types.BoolNull(),
+		IsSearchRange:    types.BoolNull(),
+		Weight:           types.Int64Null(),
+		HelpPre:          types.StringNull(),
+		HelpPost:         types.StringNull(),
+		Attributes:       types.StringNull(),
+		IsActive:         types.BoolNull(),
+		IsView:           types.BoolNull(),
+		OptionsPerLine:   types.Int64Null(),
+		TextLength:       types.Int64Null(),
+		StartDateYears:   types.Int64Null(),
+		EndDateYears:     types.Int64Null(),
+		DateFormat:       types.StringNull(),
+		TimeFormat:       types.Int64Null(),
+		NoteColumns:      types.Int64Null(),
+		NoteRows:         types.Int64Null(),
+		ColumnName:       types.StringNull(),
+		OptionGroupID:    types.Int64Null(),
+		Serialize:        types.Int64Null(),
+		Filter:           types.StringNull(),
+		InSelector:       types.BoolNull(),
+		FkEntity:         types.StringNull(),
+		FkEntityOnDelete: types.StringNull(),
+		IgnoreFields:     types.ListNull(types.StringType),
+	}
+}
+
+// validateCustomFieldConfig runs ValidateConfig on config and returns the
+// diagnostics.
+func validateCustomFieldConfig(t *testing.T, config CustomFieldResourceModel) diag.Diagnostics {
+	t.Helper()
+
+	r := &CustomFieldResource{}
+	s := newTestResource(t, r, nil)
+
+	resp := &resource.ValidateConfigResponse{}
+	r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: testConfig(t, s, config)}, resp)
+	return resp.Diagnostics
+}
+
+func TestCustomFieldResourceSerializeValidator(t *testing.T) {
+	r := &CustomFieldResource{}
+	s := newTestResource(t, r, nil)
+
+	attribute, ok := s.Attributes["serialize"].(schema.Int64Attribute)
+	if !ok {
+		t.Fatalf("serialize is a %T, want schema.Int64Attribute", s.Attributes["serialize"])
+	}
+
+	for _, tt := range []struct {
+		value   int64
+		wantErr bool
+	}{
+		{value: 0},
+		{value: 1},
+		{value: 2, wantErr: true},
+		{value: -1, wantErr: true},
+	} {
+		resp := &validator.Int64Response{}
+		for _, v := range attribute.Validators {
+			v.ValidateInt64(context.Background(), validator.Int64Request{
+				Path:        path.Root("serialize"),
+				ConfigValue: types.Int64Value(tt.value),
+			}, resp)
+		}
+		if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+			t.Errorf("serialize = %d: error = %t, want %t", tt.value, got, tt.wantErr)
+		}
+	}
+}
+
+func TestCustomFieldResourceValidateConfigSerialize(t *testing.T) {
+	tests := []struct {
+		name      string
+		htmlType  string
+		serialize types.Int64
+		wantErr   bool
+	}{
+		{name: "multi-select", htmlType: "Multi-Select", serialize: types.Int64Value(1)},
+		{name: "checkbox", htmlType: "CheckBox", serialize: types.Int64Value(1)},
+		{name: "select", htmlType: "Select", serialize: types.Int64Value(1)},
+		{name: "text", htmlType: "Text", serialize: types.Int64Value(1), wantErr: true},
+		{name: "radio", htmlType: "Radio", serialize: types.Int64Value(1), wantErr: true},
+		{name: "text without serialize", htmlType: "Text", serialize: types.Int64Value(0)},
+		{name: "text with default", htmlType: "Text", serialize: types.Int64Null()},
+		{name: "unknown serialize", htmlType: "Text", serialize: types.Int64Unknown()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := testCustomFieldConfig()
+			config.HtmlType = types.StringValue(tt.htmlType)
+			config.Serialize = tt.serialize
+
+			diags := validateCustomFieldConfig(t, config)
+			if got := hasAttributeDiagnostic(diags, diag.SeverityError, "serialize"); got != tt.wantErr {
+				t.Errorf("serialize error = %t, want %t: %v", got, tt.wantErr, diags)
+			}
+		})
+	}
+}
+
+func TestCreateWithTableRetry(t *testing.T) {
+	delay := customFieldTableRetryDelay
+	customFieldTableRetryDelay = time.Millisecond
+	t.Cleanup(func() { customFieldTableRetryDelay = delay })
+
+	missingTable := errors.New("DB Error: no such table: Table 'civicrm.civicrm_value_volunteer_5' doesn't exist")
+
+	tests := []struct {
+		name         string
+		failures     int
+		err          error
+		wantAttempts int
+		wantErr      bool
+	}{
+		{name: "table exists", wantAttempts: 1},
+		{name: "table delayed", failures: 2, err: missingTable, wantAttempts: 3},
+		{name: "table never created", failures: customFieldTableRetries, err: missingTable, wantAttempts: customFieldTableRetries, wantErr: true},
+		{name: "other error", failures: 1, err: errors.New("DB Error: already exists"), wantAttempts: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts int
+			client := newTestClient(t, func(req testRequest) ([]map[string]any, error) {
+				if req.Entity+"."+req.Action != "CustomField.create" {
+					t.Errorf("unexpected request %s.%s", req.Entity, req.Action)
+					return nil, errors.New("unexpected request")
+				}
+				attempts++
+				if attempts <= tt.failures {
+					return nil, tt.err
+				}
+				return []map[string]any{{"id": 21}}, nil
+			})
+
+			result, err := createWithTableRetry(context.Background(), client, map[string]any{"name": "shift"})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("createWithTableRetry() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+			if !tt.wantErr {
+				if id, _ := GetInt64(result, "id"); id != 21 {
+					t.Errorf("id = %d, want 21", id)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateWithTableRetryContextDone(t *testing.T) {
+	delay := customFieldTableRetryDelay
+	customFieldTableRetryDelay = time.Hour
+	t.Cleanup(func() { customFieldTableRetryDelay = delay })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client := newTestClient(t, func(req testRequest) ([]map[string]any, error) {
+		cancel()
+		return nil, errors.New("Table 'civicrm.civicrm_value_volunteer_5' doesn't exist")
+	})
+
+	if _, err := createWithTableRetry(ctx, client, map[string]any{"name": "shift"}); !errors.Is(err, context.Canceled) {
+		t.Errorf("createWithTableRetry() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestSetSizeValues(t *testing.T) {
+	tests := []struct {
+		name        string
+		dataType    string
+		htmlType    string
+		textLength  types.Int64
+		noteColumns types.Int64
+		noteRows    types.Int64
+		want        map[string]any
+	}{
+		{
+			name:       "string",
+			dataType:   "String",
+			htmlType:   "Text",
+			textLength: types.Int64Value(64),
+			want:       map[string]any{"text_length": int64(64)},
+		},
+		{
+			name:       "string with default length",
+			dataType:   "String",
+			htmlType:   "Text",
+			textLength: types.Int64Unknown(),
+			want:       map[string]any{},
+		},
+		{
+			name:       "boolean",
+			dataType:   "Boolean",
+			htmlType:   "Radio",
+			textLength: types.Int64Unknown(),
+			want:       map[string]any{},
+		},
+		{
+			name:        "memo",
+			dataType:    "Memo",
+			htmlType:    "TextArea",
+			noteColumns: types.Int64Value(60),
+			noteRows:    types.Int64Value(4),
+			want:        map[string]any{"note_columns": int64(60), "note_rows": int64(4)},
+		},
+		{
+			name:        "memo with default size",
+			dataType:    "Memo",
+			htmlType:    "TextArea",
+			noteColumns: types.Int64Unknown(),
+			noteRows:    types.Int64Null(),
+			want:        map[string]any{},
+		},
+		{
+			name:        "note size for a text field",
+			dataType:    "String",
+			htmlType:    "Text",
+			noteColumns: types.Int64Value(60),
+			noteRows:    types.Int64Value(4),
+			want:        map[string]any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := testCustomFieldConfig()
+			plan.DataType = types.StringValue(tt.dataType)
+			plan.HtmlType = types.StringValue(tt.htmlType)
+			if !tt.textLength.IsNull() {
+				plan.TextLength = tt.textLength
+			}
+			if !tt.noteColumns.IsNull() {
+				plan.NoteColumns = tt.noteColumns
+			}
+			if !tt.noteRows.IsNull() {
+				plan.NoteRows = tt.noteRows
+			}
+
+			values := map[string]any{}
+			setSizeValues(plan, values)
+			if !reflect.DeepEqual(values, tt.want) {
+				t.Errorf("setSizeValues() = %v, want %v", values, tt.want)
+			}
+		})
+	}
+}
+
+func TestCustomFieldResourceValidateConfigSizes(t *testing.T) {
+	tests := []struct {
+		name          string
+		dataType      string
+		htmlType      string
+		textLength    types.Int64
+		noteRows      types.Int64
+		wantAttribute string
+	}{
+		{name: "string text length", dataType: "String", htmlType: "Text", textLength: types.Int64Value(64)},
+		{name: "boolean text length", dataType: "Boolean", htmlType: "Radio", textLength: types.Int64Value(255), wantAttribute: "text_length"},
+		{name: "boolean without sizes", dataType: "Boolean", htmlType: "Radio"},
+		{name: "memo note rows", dataType: "Memo", htmlType: "TextArea", noteRows: types.Int64Value(4)},
+		{name: "string note rows", dataType: "String", htmlType: "Text", noteRows: types.Int64Value(4), wantAttribute: "note_rows"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := testCustomFieldConfig()
+			config.DataType = types.StringValue(tt.dataType)
+			config.HtmlType = types.StringValue(tt.htmlType)
+			if !tt.textLength.IsNull() {
+				config.TextLength = tt.textLength
+			}
+			if !tt.noteRows.IsNull() {
+				config.NoteRows = tt.noteRows
+			}
+
+			diags := validateCustomFieldConfig(t, config)
+			if tt.wantAttribute == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected errors: %v", diags)
+				}
+				return
+			}
+			if !hasAttributeDiagnostic(diags, diag.SeverityError, tt.wantAttribute) {
+				t.Errorf("missing error for %s: %v", tt.wantAttribute, diags)
+			}
+		})
+	}
+}
+
+func TestCustomFieldResourceBooleanFieldHasNoSizeDrift(t *testing.T) {
+	ctx := context.Background()
+
+	record := map[string]any{
+		"id": 44, "custom_group_id": 1, "name": "is_member", "label": "Member?",
+		"data_type": "Boolean", "html_type": "Radio", "default_value": nil,
+		"is_required": false, "is_searchable": false, "is_search_range": false, "weight": 3,
+		"help_pre": nil, "help_post": nil, "attributes": nil, "is_active": true, "is_view": false,
+		"options_per_line": nil, "text_length": nil, "start_date_years": nil, "end_date_years": nil,
+		"date_format": nil, "time_format": nil, "note_columns": nil, "note_rows": nil,
+		"column_name": "is_member_44", "option_group_id": nil, "serialize": 0, "filter": nil,
+		"in_selector": false, "fk_entity": nil, "fk_entity_on_delete": "set_null",
+	}
+	client := newTestClient(t, func(req testRequest) ([]map[string]any, error) {
+		switch req.Entity + "." + req.Action {
+		case "CustomField.create":
+			values, _ := req.Params["values"].(map[string]any)
+			for _, name := range []string{"text_length", "note_columns", "note_rows"} {
+				if _, ok := values[name]; ok {
+					t.Errorf("create sent %s = %v for a Boolean field", name, values[name])
+				}
+			}
+			return []map[string]any{record}, nil
+		case "CustomField.get":
+			return []map[string]any{record}, nil
+		}
+		t.Errorf("unexpected request %s.%s", req.Entity, req.Action)
+		return nil, errors.New("unexpected request")
+	})
+
+	r := &CustomFieldResource{}
+	s := newTestResource(t, r, client)
+
+	config := testCustomFieldConfig()
+	config.Name = types.StringValue("is_member")
+	config.Label = types.StringValue("Member?")
+	config.DataType = types.StringValue("Boolean")
+	config.HtmlType = types.StringValue("Radio")
+
+	plan := config
+	plan.ID = types.Int64Unknown()
+	plan.IsRequired = types.BoolValue(false)
+	plan.IsSearchable = types.BoolValue(false)
+	plan.IsSearchRange = types.BoolValue(false)
+	plan.Weight = types.Int64Unknown()
+	plan.IsActive = types.BoolValue(true)
+	plan.IsView = types.BoolValue(false)
+	plan.TextLength = types.Int64Unknown()
+	plan.NoteColumns = types.Int64Unknown()
+	plan.NoteRows = types.Int64Unknown()
+	plan.ColumnName = types.StringUnknown()
+	plan.Serialize = types.Int64Value(0)
+	plan.InSelector = types.BoolValue(false)
+	plan.FkEntityOnDelete = types.StringValue("set_null")
+
+	createResp := &resource.CreateResponse{State: emptyTestState(s)}
+	r.Create(ctx, resource.CreateRequest{Plan: testPlan(t, s, plan), Config: testConfig(t, s, config)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create: %v", createResp.Diagnostics)
+	}
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read: %v", readResp.Diagnostics)
+	}
+
+	if !createResp.State.Raw.Equal(readResp.State.Raw) {
+		t.Errorf("Read changed the state:\ncreate: %s\nread:   %s", createResp.State.Raw, readResp.State.Raw)
+	}
+
+	var state CustomFieldResourceModel
+	readResp.State.Get(ctx, &state)
+	for name, value := range map[string]types.Int64{
+		"text_length":  state.TextLength,
+		"note_columns": state.NoteColumns,
+		"note_rows":    state.NoteRows,
+	} {
+		if !value.IsNull() {
+			t.Errorf("%s = %s, want null for a Boolean field", name, value)
+		}
+	}
+}
+
+func TestCustomFieldResourceTimeFormatValidator(t *testing.T) {
+	r := &CustomFieldResource{}
+	s := newTestResource(t, r, nil)
+
+	attribute, ok := s.Attributes["time_format"].(schema.Int64Attribute)
+	if !ok {
+		t.Fatalf("time_format is a %T, want schema.Int64Attribute", s.Attributes["time_format"])
+	}
+
+	for _, tt := range []struct {
+		value   int64
+		wantErr bool
+	}{
+		{value: 1},
+		{value: 2},
+		{value: 0, wantErr: true},
+		{value: 24, wantErr: true},
+	} {
+		resp := &validator.Int64Response{}
+		for _, v := range attribute.Validators {
+			v.ValidateInt64(context.Background(), validator.Int64Request{
+				Path:        path.Root("time_format"),
+				ConfigValue: types.Int64Value(tt.value),
+			}, resp)
+		}
+		if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+			t.Errorf("time_format = %d: error = %t, want %t", tt.value, got, tt.wantErr)
+		}
+	}
+}
+
+func TestCustomFieldResourceValidateConfigDateFormats(t *testing.T) {
+	type attributeDiagnostic struct {
+		severity  diag.Severity
+		attribute string
+	}
+
+	tests := []struct {
+		name       string
+		dataType   string
+		dateFormat types.String
+		timeFormat types.Int64
+		want       []attributeDiagnostic
+	}{
+		{
+			name:       "date",
+			dataType:   "Date",
+			dateFormat: types.StringValue("yy-mm-dd"),
+			timeFormat: types.Int64Value(2),
+		},
+		{
+			name:       "date with textual month",
+			dataType:   "Date",
+			dateFormat: types.StringValue("d M yy"),
+			timeFormat: types.Int64Null(),
+		},
+		{
+			name:       "unrecognized date format",
+			dataType:   "Date",
+			dateFormat: types.StringValue("YYYY-MM-DD"),
+			timeFormat: types.Int64Null(),
+			want:       []attributeDiagnostic{{diag.SeverityWarning, "date_format"}},
+		},
+		{
+			name:       "unknown date format",
+			dataType:   "Date",
+			dateFormat: types.StringUnknown(),
+			timeFormat: types.Int64Null(),
+		},
+		{
+			name:       "date format on a string field",
+			dataType:   "String",
+			dateFormat: types.StringValue("yy-mm-dd"),
+			timeFormat: types.Int64Null(),
+			want:       []attributeDiagnostic{{diag.SeverityWarning, "date_format"}},
+		},
+		{
+			name:       "time format on a string field",
+			dataType:   "String",
+			dateFormat: types.StringNull(),
+			timeFormat: types.Int64Value(1),
+			want:       []attributeDiagnostic{{diag.SeverityWarning, "time_format"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := testCustomFieldConfig()
+			config.DataType = types.StringValue(tt.dataType)
+			if tt.dataType == "Date" {
+				config.HtmlType = types.StringValue("Select Date")
+			}
+			config.DateFormat = tt.dateFormat
+			config.TimeFormat = tt.timeFormat
+
+			diags := validateCustomFieldConfig(t, config)
+			if len(diags) != len(tt.want) {
+				t.Fatalf("got %d diagnostics, want %d: %v", len(diags), len(tt.want), diags)
+			}
+			for _, want := range tt.want {
+				if !hasAttributeDiagnostic(diags, want.severity, want.attribute) {
+					t.Errorf("missing %s for %s: %v", want.severity, want.attribute, diags)
+				}
+			}
+		})
+	}
+}
+
+func TestCustomFieldResourceValidateConfigSearchRange(t *testing.T) {
+	tests := []struct {
+		name          string
+		dataType      string
+		isSearchable  types.Bool
+		isSearchRange types.Bool
+		wantErrors    int
+	}{
+		{name: "searchable int range", dataType: "Int", isSearchable: types.BoolValue(true), isSearchRange: types.BoolValue(true)},
+		{name: "searchable date range", dataType: "Date", isSearchable: types.BoolValue(true), isSearchRange: types.BoolValue(true)},
+		{name: "searchable money range", dataType: "Money", isSearchable: types.BoolValue(true), isSearchRange: types.BoolValue(true)},
+		{name: "searchable string", dataType: "String", isSearchable: types.BoolValue(true), isSearchRange: types.BoolValue(false)},
+		{name: "range not searchable", dataType: "Int", isSearchable: types.BoolValue(false), isSearchRange: types.BoolValue(true), wantErrors: 1},
+		{name: "range with default searchable", dataType: "Float", isSearchable: types.BoolNull(), isSearchRange: types.BoolValue(true), wantErrors: 1},
+		{name: "range with unknown searchable", dataType: "Float", isSearchable: types.BoolUnknown(), isSearchRange: types.BoolValue(true)},
+		{name: "string range", dataType: "String", isSearchable: types.BoolValue(true), isSearchRange: types.BoolValue(true), wantErrors: 1},
+		{name: "boolean range not searchable", dataType: "Boolean", isSearchable: types.BoolValue(false), isSearchRange: types.BoolValue(true), wantErrors: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := testCustomFieldConfig()
+			config.DataType = types.StringValue(tt.dataType)
+			config.IsSearchable = tt.isSearchable
+			config.IsSearchRange = tt.isSearchRange
+
+			var got int
+			for _, d := range validateCustomFieldConfig(t, config).Errors() {
+				if d, ok := d.(diag.DiagnosticWithPath); ok && d.Path().Equal(path.Root("is_search_range")) {
+					got++
+				}
+			}
+			if got != tt.wantErrors {
+				t.Errorf("got %d is_search_range errors, want %d", got, tt.wantErrors)
+			}
+		})
+	}
+}
@@ -2,29 +2,51 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource                = &CustomGroupResource{}
-	_ resource.ResourceWithConfigure   = &CustomGroupResource{}
-	_ resource.ResourceWithImportState = &CustomGroupResource{}
+	_ resource.Resource                   = &CustomGroupResource{}
+	_ resource.ResourceWithConfigure      = &CustomGroupResource{}
+	_ resource.ResourceWithImportState    = &CustomGroupResource{}
+	_ resource.ResourceWithModifyPlan     = &CustomGroupResource{}
+	_ resource.ResourceWithValidateConfig = &CustomGroupResource{}
 )
 
+// customGroupSubtype describes how the subtype values of a known `extends`
+// entity are stored: the extends_entity_column_id CiviCRM expects and the
+// option group the extends_entity_column_value ids belong to.
+type customGroupSubtype struct {
+	columnID    int64
+	optionGroup string
+}
+
+// customGroupSubtypes lists the `extends` values whose subtype names can be
+// resolved. A zero columnID means CiviCRM stores no column id for the entity.
+var customGroupSubtypes = map[string]customGroupSubtype{
+	"Activity":             {optionGroup: "activity_type"},
+	"Event":                {optionGroup: "event_type"},
+	"ParticipantRole":      {columnID: 1, optionGroup: "participant_role"},
+	"ParticipantEventType": {columnID: 3, optionGroup: "event_type"},
+}
+
 // CustomGroupResource manages custom field groups in CiviCRM.
 type CustomGroupResource struct {
 	client *Client
@@ -51,6 +73,17 @@ type CustomGroupResourceModel struct {
 	IsReserved               types.Bool   `tfsdk:"is_reserved"`
 	IsPublic                 types.Bool   `tfsdk:"is_public"`
 	Icon                     types.String `tfsdk:"icon"`
+	AllowReservedChanges     types.Bool   `tfsdk:"allow_reserved_changes"`
+	IgnoreFields             types.List   `tfsdk:"ignore_fields"`
+}
+
+// customGroupSelect selects the CustomGroup fields that mapResponseToModel
+// reads.
+var customGroupSelect = []string{
+	"id", "name", "title", "extends", "extends_entity_column_id",
+	"extends_entity_column_value", "style", "collapse_display", "collapse_adv_display",
+	"help_pre", "help_post", "weight", "is_active", "is_public", "is_reserved",
+	"table_name", "is_multiple", "min_multiple", "max_multiple", "icon",
 }
 
 func NewCustomGroupResource() resource.Resource {
@@ -85,11 +118,12 @@ func (r *CustomGroupResource) Schema(ctx context.Context, req resource.SchemaReq
 				Required:    true,
 			},
 			"extends_entity_column_id": schema.Int64Attribute{
-				Description: "For extending specific subtypes, the column ID.",
+				Description: "For extending specific subtypes, the column ID. Set automatically for 'ParticipantRole' and 'ParticipantEventType'.",
 				Optional:    true,
+				Computed:    true,
 			},
 			"extends_entity_column_value": schema.ListAttribute{
-				Description: "For extending specific subtypes, the allowed values.",
+				Description: "For extending specific subtypes, the allowed values. For 'Activity', 'Event', 'ParticipantRole' and 'ParticipantEventType' these may be option value names (e.g. 'Meeting'), which are resolved to their ids.",
 				Optional:    true,
 				ElementType: types.StringType,
 			},
@@ -98,6 +132,9 @@ func (r *CustomGroupResource) Schema(ctx context.Context, req resource.SchemaReq
 				Optional:    true,
 				Computed:    true,
 				Default:     stringdefault.StaticString("Inline"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("Inline", "Tab", "Tab with table"),
+				},
 			},
 			"collapse_display": schema.BoolAttribute{
 				Description: "Whether to collapse the group display by default. Default: false.",
@@ -113,12 +150,7 @@ func (r *CustomGroupResource) Schema(ctx context.Context, req resource.SchemaReq
 				Description: "Help text displayed after the custom fields.",
 				Optional:    true,
 			},
-			"weight": schema.Int64Attribute{
-				Description: "The display order weight. Default: 1.",
-				Optional:    true,
-				Computed:    true,
-				Default:     int64default.StaticInt64(1),
-			},
+			"weight": managedWeightAttribute("custom group"),
 			"is_active": schema.BoolAttribute{
 				Description: "Whether the custom group is active. Default: true.",
 				Optional:    true,
@@ -169,10 +201,44 @@ func (r *CustomGroupResource) Schema(ctx context.Context, req resource.SchemaReq
 				Description: "The icon for the custom group (CSS class name).",
 				Optional:    true,
 			},
+			"allow_reserved_changes": allowReservedChangesAttribute,
+			"ignore_fields":          ignoreFieldsAttribute,
 		},
 	}
 }
 
+// ValidateConfig rejects the 'Tab with table' style for single-record groups.
+// CiviCRM only supports it for multi-record groups and otherwise stores a
+// different style than the one configured. It also rejects ignore_fields
+// entries that name no attribute or one that cannot be ignored.
+func (r *CustomGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateIgnoredFields(ctx, req.Config, &resp.Diagnostics)
+
+	var config CustomGroupResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateCustomGroupStyle(config.Style, config.IsMultiple, &resp.Diagnostics)
+}
+
+// validateCustomGroupStyle rejects the 'Tab with table' style unless
+// isMultiple is set. It is shared by the resources that manage custom groups.
+func validateCustomGroupStyle(style types.String, isMultiple types.Bool, diags *diag.Diagnostics) {
+	if style.ValueString() != "Tab with table" || isMultiple.IsUnknown() {
+		return
+	}
+
+	if !isMultiple.ValueBool() {
+		diags.AddAttributeError(
+			path.Root("style"),
+			"Invalid style",
+			"The 'Tab with table' style is only supported for multi-record custom groups. Set is_multiple = true or use 'Tab'.",
+		)
+	}
+}
+
 func (r *CustomGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -210,7 +276,6 @@ func (r *CustomGroupResource) Create(ctx context.Context, req resource.CreateReq
 		"extends":              plan.Extends.ValueString(),
 		"style":                plan.Style.ValueString(),
 		"collapse_display":     plan.CollapseDisplay.ValueBool(),
-		"weight":               plan.Weight.ValueInt64(),
 		"is_active":            plan.IsActive.ValueBool(),
 		"is_multiple":          plan.IsMultiple.ValueBool(),
 		"collapse_adv_display": plan.CollapseAdvDisplay.ValueBool(),
@@ -218,7 +283,11 @@ func (r *CustomGroupResource) Create(ctx context.Context, req resource.CreateReq
 		"is_public":            plan.IsPublic.ValueBool(),
 	}
 
-	if !plan.ExtendsEntityColumnID.IsNull() {
+	if weight, ok := configuredWeight(ctx, req.Config, &resp.Diagnostics); ok {
+		values["weight"] = weight
+	}
+
+	if !plan.ExtendsEntityColumnID.IsNull() && !plan.ExtendsEntityColumnID.IsUnknown() {
 		values["extends_entity_column_id"] = plan.ExtendsEntityColumnID.ValueInt64()
 	}
 
@@ -229,6 +298,14 @@ func (r *CustomGroupResource) Create(ctx context.Context, req resource.CreateReq
 		if resp.Diagnostics.HasError() {
 			return
 		}
+		columnValues, err := r.resolveSubtypeValues(ctx, plan.Extends.ValueString(), columnValues)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error resolving custom group subtypes",
+				"Could not resolve extends_entity_column_value: "+err.Error(),
+			)
+			return
+		}
 		values["extends_entity_column_value"] = columnValues
 	}
 
@@ -257,7 +334,7 @@ func (r *CustomGroupResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// Call API
-	result, err := r.client.Create("CustomGroup", values)
+	result, err := r.client.Create(ctx, "CustomGroup", values)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating custom group",
@@ -267,8 +344,10 @@ func (r *CustomGroupResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// Update state with response
+	configured := plan.ExtendsEntityColumnValue
 	var d diag.Diagnostics
 	r.mapResponseToModel(ctx, result, &plan, &d)
+	r.keepSubtypeNames(ctx, configured, &plan, &d)
 	resp.Diagnostics.Append(d...)
 
 	tflog.Debug(ctx, "Created custom group", map[string]any{
@@ -291,7 +370,14 @@ func (r *CustomGroupResource) Read(ctx context.Context, req resource.ReadRequest
 		"id": state.ID.ValueInt64(),
 	})
 
-	result, err := r.client.GetByID("CustomGroup", state.ID.ValueInt64(), nil)
+	result, err := r.client.GetByID(ctx, "CustomGroup", state.ID.ValueInt64(), customGroupSelect)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Custom group no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading custom group",
@@ -301,12 +387,18 @@ func (r *CustomGroupResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	// Update state
+	prior := state.ExtendsEntityColumnValue
 	var d diag.Diagnostics
 	r.mapResponseToModel(ctx, result, &state, &d)
+	r.keepSubtypeNames(ctx, prior, &state, &d)
 	resp.Diagnostics.Append(d...)
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+
+	// Keep the prior value of attributes managed outside of Terraform
+	ignored := ignoredFields(ctx, state.IgnoreFields, &resp.Diagnostics)
+	keepIgnoredFields(ctx, ignored, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *CustomGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -335,7 +427,6 @@ func (r *CustomGroupResource) Update(ctx context.Context, req resource.UpdateReq
 		"extends":              plan.Extends.ValueString(),
 		"style":                plan.Style.ValueString(),
 		"collapse_display":     plan.CollapseDisplay.ValueBool(),
-		"weight":               plan.Weight.ValueInt64(),
 		"is_active":            plan.IsActive.ValueBool(),
 		"is_multiple":          plan.IsMultiple.ValueBool(),
 		"collapse_adv_display": plan.CollapseAdvDisplay.ValueBool(),
@@ -343,7 +434,11 @@ func (r *CustomGroupResource) Update(ctx context.Context, req resource.UpdateReq
 		"is_public":            plan.IsPublic.ValueBool(),
 	}
 
-	if !plan.ExtendsEntityColumnID.IsNull() {
+	if weight, ok := configuredWeight(ctx, req.Config, &resp.Diagnostics); ok {
+		values["weight"] = weight
+	}
+
+	if !plan.ExtendsEntityColumnID.IsNull() && !plan.ExtendsEntityColumnID.IsUnknown() {
 		values["extends_entity_column_id"] = plan.ExtendsEntityColumnID.ValueInt64()
 	} else {
 		values["extends_entity_column_id"] = nil
@@ -356,6 +451,14 @@ func (r *CustomGroupResource) Update(ctx context.Context, req resource.UpdateReq
 		if resp.Diagnostics.HasError() {
 			return
 		}
+		columnValues, err := r.resolveSubtypeValues(ctx, plan.Extends.ValueString(), columnValues)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error resolving custom group subtypes",
+				"Could not resolve extends_entity_column_value: "+err.Error(),
+			)
+			return
+		}
 		values["extends_entity_column_value"] = columnValues
 	} else {
 		values["extends_entity_column_value"] = nil
@@ -391,8 +494,14 @@ func (r *CustomGroupResource) Update(ctx context.Context, req resource.UpdateReq
 		values["icon"] = nil
 	}
 
+	ignored := ignoredFields(ctx, plan.IgnoreFields, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	omitIgnoredFields(values, ignored, nil)
+
 	// Call API
-	result, err := r.client.Update("CustomGroup", state.ID.ValueInt64(), values)
+	result, err := r.client.Update(ctx, "CustomGroup", state.ID.ValueInt64(), values)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating custom group",
@@ -403,8 +512,10 @@ func (r *CustomGroupResource) Update(ctx context.Context, req resource.UpdateReq
 
 	// Update state
 	plan.ID = state.ID
+	configured := plan.ExtendsEntityColumnValue
 	var d diag.Diagnostics
 	r.mapResponseToModel(ctx, result, &plan, &d)
+	r.keepSubtypeNames(ctx, configured, &plan, &d)
 	resp.Diagnostics.Append(d...)
 
 	tflog.Debug(ctx, "Updated custom group", map[string]any{
@@ -413,6 +524,8 @@ func (r *CustomGroupResource) Update(ctx context.Context, req resource.UpdateReq
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	keepIgnoredFields(ctx, ignored, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *CustomGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -427,7 +540,7 @@ func (r *CustomGroupResource) Delete(ctx context.Context, req resource.DeleteReq
 		"id": state.ID.ValueInt64(),
 	})
 
-	err := r.client.Delete("CustomGroup", state.ID.ValueInt64())
+	err := r.client.Delete(ctx, "CustomGroup", state.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting custom group",
@@ -452,6 +565,125 @@ func (r *CustomGroupResource) ImportState(ctx context.Context, req resource.Impo
 	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("allow_reserved_changes"), false)...)
+}
+
+// ModifyPlan fills in the subtype column id and protects reserved custom groups
+// from accidental changes.
+func (r *CustomGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.planSubtypeColumnID(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkReservedChange(ctx, req, resp, "custom group")
+}
+
+// planSubtypeColumnID fills in extends_entity_column_id for known `extends`
+// values when it is not configured, so users do not have to supply the magic
+// column ids.
+func (r *CustomGroupResource) planSubtypeColumnID(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config CustomGroupResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ExtendsEntityColumnID.IsNull() || config.Extends.IsUnknown() {
+		return
+	}
+
+	columnID := types.Int64Null()
+	if subtype, ok := customGroupSubtypes[config.Extends.ValueString()]; ok && subtype.columnID != 0 {
+		columnID = types.Int64Value(subtype.columnID)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("extends_entity_column_id"), columnID)...)
+}
+
+// resolveSubtypeValues converts subtype names into option value ids for the
+// `extends` values listed in customGroupSubtypes, looking up all names in one
+// request. Numeric values and values for other entities are passed through
+// unchanged.
+func (r *CustomGroupResource) resolveSubtypeValues(ctx context.Context, extends string, values []string) ([]string, error) {
+	subtype, ok := customGroupSubtypes[extends]
+	if !ok {
+		return values, nil
+	}
+
+	var names []string
+	for _, v := range values {
+		if _, err := strconv.ParseInt(v, 10, 64); err != nil && !slices.Contains(names, v) {
+			names = append(names, v)
+		}
+	}
+	if len(names) == 0 {
+		return values, nil
+	}
+
+	results, err := r.client.Get(ctx, "OptionValue", [][]any{
+		{"option_group_id:name", "=", subtype.optionGroup},
+		{"name", "IN", names},
+	}, []string{"name", "value"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up %v in option group '%s': %w", names, subtype.optionGroup, err)
+	}
+
+	byName := make(map[string]string, len(results))
+	for _, result := range results {
+		name, _ := GetString(result, "name")
+		if value, ok := GetString(result, "value"); ok {
+			byName[name] = value
+		}
+	}
+
+	resolved := make([]string, 0, len(values))
+	for _, v := range values {
+		if _, err := strconv.ParseInt(v, 10, 64); err == nil {
+			resolved = append(resolved, v)
+			continue
+		}
+
+		value, ok := byName[v]
+		if !ok {
+			return nil, fmt.Errorf("'%s' not found in option group '%s'", v, subtype.optionGroup)
+		}
+		resolved = append(resolved, value)
+	}
+
+	return resolved, nil
+}
+
+// keepSubtypeNames keeps the configured subtype names in the model when they
+// resolve to the ids CiviCRM returned, so that names do not show up as drift.
+func (r *CustomGroupResource) keepSubtypeNames(ctx context.Context, configured types.List, model *CustomGroupResourceModel, diags *diag.Diagnostics) {
+	if configured.IsNull() || configured.IsUnknown() || model.ExtendsEntityColumnValue.IsNull() {
+		return
+	}
+
+	var names, ids []string
+	diags.Append(configured.ElementsAs(ctx, &names, false)...)
+	diags.Append(model.ExtendsEntityColumnValue.ElementsAs(ctx, &ids, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	resolved, err := r.resolveSubtypeValues(ctx, model.Extends.ValueString(), names)
+	if err != nil {
+		tflog.Warn(ctx, "Could not resolve custom group subtypes", map[string]any{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if slices.Equal(resolved, ids) {
+		model.ExtendsEntityColumnValue = configured
+	}
 }
 
 func (r *CustomGroupResource) mapResponseToModel(ctx context.Context, result map[string]any, model *CustomGroupResourceModel, diags *diag.Diagnostics) {
@@ -508,20 +740,14 @@ func (r *CustomGroupResource) mapResponseToModel(ctx context.Context, result map
 		model.CollapseDisplay = types.BoolValue(collapseDisplay)
 	}
 
-	if helpPre, ok := GetString(result, "help_pre"); ok && helpPre != "" {
-		model.HelpPre = types.StringValue(helpPre)
-	} else {
-		model.HelpPre = types.StringNull()
-	}
+	model.HelpPre = r.client.optionalString(result, "help_pre", model.HelpPre)
 
-	if helpPost, ok := GetString(result, "help_post"); ok && helpPost != "" {
-		model.HelpPost = types.StringValue(helpPost)
-	} else {
-		model.HelpPost = types.StringNull()
-	}
+	model.HelpPost = r.client.optionalString(result, "help_post", model.HelpPost)
 
 	if weight, ok := GetInt64(result, "weight"); ok {
 		model.Weight = types.Int64Value(weight)
+	} else if model.Weight.IsUnknown() {
+		model.Weight = types.Int64Null()
 	}
 
 	if isActive, ok := GetBool(result, "is_active"); ok {
@@ -560,9 +786,5 @@ func (r *CustomGroupResource) mapResponseToModel(ctx context.Context, result map
 		model.IsPublic = types.BoolValue(isPublic)
 	}
 
-	if icon, ok := GetString(result, "icon"); ok && icon != "" {
-		model.Icon = types.StringValue(icon)
-	} else {
-		model.Icon = types.StringNull()
-	}
+	model.Icon = r.client.optionalString(result, "icon", model.Icon)
 }
@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCustomGroupResourceStyleToggle(t *testing.T) {
+	tests := []struct {
+		name       string
+		isMultiple bool
+		styles     []string
+	}{
+		{name: "single-record", styles: []string{"Inline", "Tab", "Inline"}},
+		{name: "multi-record", isMultiple: true, styles: []string{"Tab", "Tab with table", "Inline", "Tab with table"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			record := map[string]any{
+				"id": 6, "name": "volunteer_info", "title": "Volunteer Info", "extends": "Contact",
+				"extends_entity_column_id": nil, "extends_entity_column_value": nil, "style": tt.styles[0],
+				"collapse_display": false, "collapse_adv_display": false, "help_pre": nil, "help_post": nil,
+				"weight": 4, "is_active": true, "is_public": true, "is_reserved": false,
+				"table_name": "civicrm_value_volunteer_info_6", "is_multiple": tt.isMultiple,
+				"min_multiple": nil, "max_multiple": nil, "icon": nil,
+			}
+			client := newTestClient(t, func(req testRequest) ([]map[string]any, error) {
+				switch req.Entity + "." + req.Action {
+				case "CustomGroup.update":
+					values, _ := req.Params["values"].(map[string]any)
+					maps.Copy(record, values)
+					return []map[string]any{record}, nil
+				case "CustomGroup.get":
+					return []map[string]any{record}, nil
+				}
+				t.Errorf("unexpected request %s.%s", req.Entity, req.Action)
+				return nil, errors.New("unexpected request")
+			})
+
+			r := &CustomGroupResource{}
+			s := newTestResource(t, r, client)
+
+			state := testState(t, s, CustomGroupResourceModel{
+				ID:                       types.Int64Value(6),
+				Name:                     types.StringValue("volunteer_info"),
+				Title:                    types.StringValue("Volunteer Info"),
+				Extends:                  types.StringValue("Contact"),
+				ExtendsEntityColumnID:    types.Int64Null(),
+				ExtendsEntityColumnValue: types.ListNull(types.StringType),
+				Style:                    types.StringValue(tt.styles[0]),
+				CollapseDisplay:          types.BoolValue(false),
+				HelpPre:                  types.StringNull(),
+				HelpPost:                 types.StringNull(),
+				Weight:                   types.Int64Value(4),
+				IsActive:                 types.BoolValue(true),
+				TableName:                types.StringValue("civicrm_value_volunteer_info_6"),
+				IsMultiple:               types.BoolValue(tt.isMultiple),
+				MinMultiple:              types.Int64Null(),
+				MaxMultiple:              types.Int64Null(),
+				CollapseAdvDisplay:       types.BoolValue(false),
+				IsReserved:               types.BoolValue(false),
+				IsPublic:                 types.BoolValue(true),
+				Icon:                     types.StringNull(),
+				AllowReservedChanges:     types.BoolValue(false),
+				IgnoreFields:             types.ListNull(types.StringType),
+			})
+
+			for _, style := range tt.styles[1:] {
+				var plan CustomGroupResourceModel
+				state.Get(ctx, &plan)
+				plan.Style = types.StringValue(style)
+
+				config := plan
+				config.ID = types.Int64Null()
+				config.Weight = types.Int64Null()
+				config.TableName = types.StringNull()
+
+				if diags := validateCustomGroupConfig(t, config); diags.HasError() {
+					t.Fatalf("style %q: ValidateConfig: %v", style, diags)
+				}
+
+				planned := testPlan(t, s, plan)
+				updateResp := &resource.UpdateResponse{State: state}
+				r.Update(ctx, resource.UpdateRequest{Plan: planned, Config: testConfig(t, s, config), State: state}, updateResp)
+				if updateResp.Diagnostics.HasError() {
+					t.Fatalf("style %q: Update: %v", style, updateResp.Diagnostics)
+				}
+
+				// Terraform rejects an apply whose result differs from the plan
+				if !updateResp.State.Raw.Equal(planned.Raw) {
+					t.Errorf("style %q: Update produced an inconsistent result:\nplan:  %s\nstate: %s", style, planned.Raw, updateResp.State.Raw)
+				}
+
+				readResp := &resource.ReadResponse{State: updateResp.State}
+				r.Read(ctx, resource.ReadRequest{State: updateResp.State}, readResp)
+				if readResp.Diagnostics.HasError() {
+					t.Fatalf("style %q: Read: %v", style, readResp.Diagnostics)
+				}
+				if !readResp.State.Raw.Equal(updateResp.State.Raw) {
+					t.Errorf("style %q: Read changed the state:\nupdate: %s\nread:   %s", style, updateResp.State.Raw, readResp.State.Raw)
+				}
+
+				state = readResp.State
+			}
+		})
+	}
+}
+
+func TestCustomGroupResourceValidateConfigStyle(t *testing.T) {
+	tests := []struct {
+		style      string
+		isMultiple types.Bool
+		wantErr    bool
+	}{
+		{style: "Inline", isMultiple: types.BoolValue(false)},
+		{style: "Tab", isMultiple: types.BoolValue(false)},
+		{style: "Tab", isMultiple: types.BoolValue(true)},
+		{style: "Tab with table", isMultiple: types.BoolValue(true)},
+		{style: "Tab with table", isMultiple: types.BoolValue(false), wantErr: true},
+		{style: "Tab with table", isMultiple: types.BoolNull(), wantErr: true},
+		{style: "Tab with table", isMultiple: types.BoolUnknown()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.style+" "+tt.isMultiple.String(), func(t *testing.T) {
+			config := CustomGroupResourceModel{
+				ID:                       types.Int64Null(),
+				Name:                     types.StringValue("volunteer_info"),
+				Title:                    types.StringValue("Volunteer Info"),
+				Extends:                  types.StringValue("Contact"),
+				ExtendsEntityColumnID:    types.Int64Null(),
+				ExtendsEntityColumnValue: types.ListNull(types.StringType),
+				Style:                    types.StringValue(tt.style),
+				CollapseDisplay:          types.BoolNull(),
+				HelpPre:                  types.StringNull(),
+				HelpPost:                 types.StringNull(),
+				Weight:                   types.Int64Null(),
+				IsActive:                 types.BoolNull(),
+				TableName:                types.StringNull(),
+				IsMultiple:               tt.isMultiple,
+				MinMultiple:              types.Int64Null(),
+				MaxMultiple:              types.Int64Null(),
+				CollapseAdvDisplay:       types.BoolNull(),
+				IsReserved:               types.BoolNull(),
+				IsPublic:                 types.BoolNull(),
+				Icon:                     types.StringNull(),
+				AllowReservedChanges:     types.BoolNull(),
+				IgnoreFields:             types.ListNull(types.StringType),
+			}
+
+			diags := validateCustomGroupConfig(t, config)
+			if got := hasAttributeDiagnostic(diags, diag.SeverityError, "style"); got != tt.wantErr {
+				t.Errorf("style error = %t, want %t: %v", got, tt.wantErr, diags)
+			}
+		})
+	}
+}
+
+// validateCustomGroupConfig runs ValidateConfig on config and returns the
+// diagnostics.
+func validateCustomGroupConfig(t *testing.T, config CustomGroupResourceModel) diag.Diagnostics {
+	t.Helper()
+
+	r := &CustomGroupResource{}
+	s := newTestResource(t, r, nil)
+
+	resp := &resource.ValidateConfigResponse{}
+	r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: testConfig(t, s, config)}, resp)
+	return resp.Diagnostics
+}
+
+func TestCustomGroupResourceResolveSubtypeValues(t *testing.T) {
+	tests := []struct {
+		name         string
+		extends      string
+		values       []string
+		want         []string
+		wantRequests int
+		wantErr      bool
+	}{
+		{name: "names", extends: "Event", values: []string{"Conference", "Workshop"}, want: []string{"1", "2"}, wantRequests: 1},
+		{name: "names and ids", extends: "Event", values: []string{"Workshop", "7", "Conference", "Workshop"}, want: []string{"2", "7", "1", "2"}, wantRequests: 1},
+		{name: "ids", extends: "Event", values: []string{"1", "2"}, want: []string{"1", "2"}},
+		{name: "other entity", extends: "Contact", values: []string{"Volunteer"}, want: []string{"Volunteer"}},
+		{name: "unknown name", extends: "Event", values: []string{"Conference", "Retreat"}, wantRequests: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventTypes := map[string]string{"Conference": "1", "Workshop": "2"}
+			requests := 0
+			client := newTestClient(t, func(req testRequest) ([]map[string]any, error) {
+				if req.Entity+"."+req.Action != "OptionValue.get" {
+					t.Errorf("unexpected request %s.%s", req.Entity, req.Action)
+					return nil, errors.New("unexpected request")
+				}
+				requests++
+
+				where, _ := req.Params["where"].([]any)
+				var results []map[string]any
+				for _, condition := range where {
+					clause, _ := condition.([]any)
+					if clause[0] != "name" {
+						continue
+					}
+					if clause[1] != "IN" {
+						t.Errorf("names looked up with %v, want IN", clause[1])
+					}
+					names, _ := clause[2].([]any)
+					for _, name := range names {
+						if value, ok := eventTypes[name.(string)]; ok {
+							results = append(results, map[string]any{"name": name, "value": value})
+						}
+					}
+				}
+				return results, nil
+			})
+
+			r := &CustomGroupResource{client: client}
+			got, err := r.resolveSubtypeValues(context.Background(), tt.extends, tt.values)
+			if requests != tt.wantRequests {
+				t.Errorf("made %d requests, want %d", requests, tt.wantRequests)
+			}
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("resolveSubtypeValues() = %v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveSubtypeValues: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("resolveSubtypeValues() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,776 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &CustomSchemaResource{}
+	_ resource.ResourceWithConfigure      = &CustomSchemaResource{}
+	_ resource.ResourceWithImportState    = &CustomSchemaResource{}
+	_ resource.ResourceWithModifyPlan     = &CustomSchemaResource{}
+	_ resource.ResourceWithValidateConfig = &CustomSchemaResource{}
+)
+
+// CustomSchemaResource manages a custom group together with all of its custom
+// fields. It composes what civicrm_custom_group and civicrm_custom_field
+// manage separately, for data models with many fields.
+type CustomSchemaResource struct {
+	client *Client
+}
+
+type CustomSchemaResourceModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Title      types.String `tfsdk:"title"`
+	Extends    types.String `tfsdk:"extends"`
+	Style      types.String `tfsdk:"style"`
+	IsMultiple types.Bool   `tfsdk:"is_multiple"`
+	IsActive   types.Bool   `tfsdk:"is_active"`
+	TableName  types.String `tfsdk:"table_name"`
+	Fields     types.List   `tfsdk:"fields"`
+	FieldIDs   types.Map    `tfsdk:"field_ids"`
+}
+
+type CustomSchemaFieldModel struct {
+	Name          types.String `tfsdk:"name"`
+	Label         types.String `tfsdk:"label"`
+	DataType      types.String `tfsdk:"data_type"`
+	HtmlType      types.String `tfsdk:"html_type"`
+	DefaultValue  types.String `tfsdk:"default_value"`
+	OptionGroupID types.Int64  `tfsdk:"option_group_id"`
+	IsRequired    types.Bool   `tfsdk:"is_required"`
+	IsSearchable  types.Bool   `tfsdk:"is_searchable"`
+	IsActive      types.Bool   `tfsdk:"is_active"`
+}
+
+// customSchemaFieldType is the object type of an entry in fields.
+var customSchemaFieldType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":            types.StringType,
+		"label":           types.StringType,
+		"data_type":       types.StringType,
+		"html_type":       types.StringType,
+		"default_value":   types.StringType,
+		"option_group_id": types.Int64Type,
+		"is_required":     types.BoolType,
+		"is_searchable":   types.BoolType,
+		"is_active":       types.BoolType,
+	},
+}
+
+// customSchemaGroupSelect selects the CustomGroup fields that mapGroupToModel
+// reads.
+var customSchemaGroupSelect = []string{
+	"id", "name", "title", "extends", "style", "is_active", "is_multiple", "table_name",
+}
+
+func NewCustomSchemaResource() resource.Resource {
+	return &CustomSchemaResource{}
+}
+
+func (r *CustomSchemaResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_schema"
+}
+
+func (r *CustomSchemaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a CiviCRM custom group and all of its custom fields as one unit. This is a shortcut for a civicrm_custom_group together with a civicrm_custom_field per field.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier of the custom group.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The machine name of the custom group (must be unique).",
+				Required:    true,
+			},
+			"title": schema.StringAttribute{
+				Description: "The display title of the custom group.",
+				Required:    true,
+			},
+			"extends": schema.StringAttribute{
+				Description: "The entity type the custom group extends (e.g., 'Contact', 'Individual', 'Activity', 'Contribution'). Changing this forces a new custom group.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"style": schema.StringAttribute{
+				Description: "The display style. Options: 'Inline', 'Tab', 'Tab with table'. 'Tab with table' requires is_multiple = true. Default: 'Inline'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("Inline"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("Inline", "Tab", "Tab with table"),
+				},
+			},
+			"is_multiple": schema.BoolAttribute{
+				Description: "Whether multiple records can be stored per entity. Changing this forces a new custom group. Default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_active": schema.BoolAttribute{
+				Description: "Whether the custom group is active. Default: true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"table_name": schema.StringAttribute{
+				Description: "The database table CiviCRM stores the field values in.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fields": schema.ListNestedAttribute{
+				Description: "The custom fields of the group, in display order. Fields are matched by name; fields of the group that are not listed are deleted.",
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The machine name of the custom field (must be unique within the group).",
+							Required:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "The display label of the custom field.",
+							Required:    true,
+						},
+						"data_type": schema.StringAttribute{
+							Description: "The data type, as for civicrm_custom_field. Changing it deletes the field and its data and creates it again.",
+							Required:    true,
+						},
+						"html_type": schema.StringAttribute{
+							Description: "The HTML input type, as for civicrm_custom_field.",
+							Required:    true,
+						},
+						"default_value": schema.StringAttribute{
+							Description: "The default value for the field.",
+							Optional:    true,
+						},
+						"option_group_id": schema.Int64Attribute{
+							Description: "The option group for fields with options.",
+							Optional:    true,
+						},
+						"is_required": schema.BoolAttribute{
+							Description: "Whether the field is required. Default: false.",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+						},
+						"is_searchable": schema.BoolAttribute{
+							Description: "Whether the field is searchable. Default: false.",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+						},
+						"is_active": schema.BoolAttribute{
+							Description: "Whether the field is active. Default: true.",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(true),
+						},
+					},
+				},
+			},
+			"field_ids": schema.MapAttribute{
+				Description: "The IDs of the custom fields, keyed by field name.",
+				Computed:    true,
+				ElementType: types.Int64Type,
+			},
+		},
+	}
+}
+
+// ValidateConfig rejects the 'Tab with table' style for single-record groups,
+// as civicrm_custom_group does, and duplicate field names, which would
+// otherwise fail halfway through creating the fields.
+func (r *CustomSchemaResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var style types.String
+	var isMultiple types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("style"), &style)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("is_multiple"), &isMultiple)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validateCustomGroupStyle(style, isMultiple, &resp.Diagnostics)
+
+	var fieldsList types.List
+	diags := req.Config.GetAttribute(ctx, path.Root("fields"), &fieldsList)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() || fieldsList.IsNull() || fieldsList.IsUnknown() {
+		return
+	}
+
+	var fields []CustomSchemaFieldModel
+	diags = fieldsList.ElementsAs(ctx, &fields, false)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	seen := make(map[string]bool, len(fields))
+	for i, field := range fields {
+		if field.Name.IsUnknown() || field.Name.IsNull() {
+			continue
+		}
+		name := field.Name.ValueString()
+		if seen[name] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("fields").AtListIndex(i).AtName("name"),
+				"Duplicate custom field name",
+				fmt.Sprintf("The field name %q is used more than once; field names must be unique within the custom group.", name),
+			)
+		}
+		seen[name] = true
+	}
+}
+
+// ModifyPlan keeps the planned field IDs as long as no field is added,
+// removed or recreated.
+func (r *CustomSchemaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state CustomSchemaResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Fields.IsUnknown() {
+		return
+	}
+
+	planFields := customSchemaFields(ctx, plan, &resp.Diagnostics)
+	stateFields := customSchemaFields(ctx, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || len(planFields) != len(stateFields) {
+		return
+	}
+
+	stateTypes := make(map[string]types.String, len(stateFields))
+	for _, field := range stateFields {
+		stateTypes[field.Name.ValueString()] = field.DataType
+	}
+	for _, field := range planFields {
+		dataType, ok := stateTypes[field.Name.ValueString()]
+		if field.Name.IsUnknown() || !ok || !dataType.Equal(field.DataType) {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("field_ids"), state.FieldIDs)...)
+}
+
+func (r *CustomSchemaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CustomSchemaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan CustomSchemaResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields := customSchemaFields(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating custom schema", map[string]any{
+		"name":   plan.Name.ValueString(),
+		"fields": len(fields),
+	})
+
+	group, err := r.client.Create(ctx, "CustomGroup", map[string]any{
+		"name":        plan.Name.ValueString(),
+		"title":       plan.Title.ValueString(),
+		"extends":     plan.Extends.ValueString(),
+		"style":       plan.Style.ValueString(),
+		"is_multiple": plan.IsMultiple.ValueBool(),
+		"is_active":   plan.IsActive.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating custom schema",
+			"Could not create custom group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	r.mapGroupToModel(group, &plan)
+
+	// Fields are created in order, so their weights follow the configuration
+	fieldIDs := make([]int64, 0, len(fields))
+	for i, field := range fields {
+		values := customSchemaFieldValues(field, i, false)
+		values["custom_group_id"] = plan.ID.ValueInt64()
+
+		result, err := createWithTableRetry(ctx, r.client, values)
+		if err != nil {
+			// Do not leave a half-created schema behind
+			r.rollback(ctx, plan.ID.ValueInt64(), fieldIDs)
+			resp.Diagnostics.AddError(
+				"Error creating custom schema",
+				"Could not create custom field "+field.Name.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+		if id, ok := GetInt64(result, "id"); ok {
+			fieldIDs = append(fieldIDs, id)
+		}
+	}
+
+	r.readFields(ctx, fields, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Created custom schema", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *CustomSchemaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state CustomSchemaResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading custom schema", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	group, err := r.client.GetByID(ctx, "CustomGroup", state.ID.ValueInt64(), customSchemaGroupSelect)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Custom schema no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading custom schema",
+			"Could not read custom group ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+	r.mapGroupToModel(group, &state)
+
+	var prior []CustomSchemaFieldModel
+	if !state.Fields.IsNull() {
+		prior = customSchemaFields(ctx, state, &resp.Diagnostics)
+	}
+	r.readFields(ctx, prior, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *CustomSchemaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan CustomSchemaResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state CustomSchemaResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields := customSchemaFields(ctx, plan, &resp.Diagnostics)
+	stateFields := customSchemaFields(ctx, state, &resp.Diagnostics)
+	stateIDs := make(map[string]int64, len(state.FieldIDs.Elements()))
+	resp.Diagnostics.Append(state.FieldIDs.ElementsAs(ctx, &stateIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating custom schema", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	group, err := r.client.Update(ctx, "CustomGroup", state.ID.ValueInt64(), map[string]any{
+		"name":      plan.Name.ValueString(),
+		"title":     plan.Title.ValueString(),
+		"style":     plan.Style.ValueString(),
+		"is_active": plan.IsActive.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating custom schema",
+			"Could not update custom group ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+	plan.ID = state.ID
+	r.mapGroupToModel(group, &plan)
+
+	planTypes := make(map[string]string, len(fields))
+	for _, field := range fields {
+		planTypes[field.Name.ValueString()] = field.DataType.ValueString()
+	}
+
+	// Delete removed fields, and fields whose data type changed, before
+	// creating any field that might reuse their name
+	for _, field := range stateFields {
+		name := field.Name.ValueString()
+		dataType, ok := planTypes[name]
+		if ok && dataType == field.DataType.ValueString() {
+			continue
+		}
+
+		id, ok := stateIDs[name]
+		if !ok {
+			continue
+		}
+		if err := r.client.Delete(ctx, "CustomField", id); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating custom schema",
+				"Could not delete custom field "+name+": "+err.Error(),
+			)
+			return
+		}
+		delete(stateIDs, name)
+	}
+
+	for i, field := range fields {
+		name := field.Name.ValueString()
+		if id, ok := stateIDs[name]; ok {
+			if _, err := r.client.Update(ctx, "CustomField", id, customSchemaFieldValues(field, i, true)); err != nil {
+				resp.Diagnostics.AddError(
+					"Error updating custom schema",
+					"Could not update custom field "+name+": "+err.Error(),
+				)
+				return
+			}
+			continue
+		}
+
+		values := customSchemaFieldValues(field, i, false)
+		values["custom_group_id"] = plan.ID.ValueInt64()
+		if _, err := createWithTableRetry(ctx, r.client, values); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating custom schema",
+				"Could not create custom field "+name+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	r.readFields(ctx, fields, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updated custom schema", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *CustomSchemaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state CustomSchemaResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields := customSchemaFields(ctx, state, &resp.Diagnostics)
+	fieldIDs := make(map[string]int64, len(state.FieldIDs.Elements()))
+	resp.Diagnostics.Append(state.FieldIDs.ElementsAs(ctx, &fieldIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting custom schema", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	// Remove the fields before the group they belong to
+	for _, field := range fields {
+		id, ok := fieldIDs[field.Name.ValueString()]
+		if !ok {
+			continue
+		}
+		if err := r.client.Delete(ctx, "CustomField", id); err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting custom schema",
+				"Could not delete custom field "+field.Name.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	err := r.client.Delete(ctx, "CustomGroup", state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting custom schema",
+			"Could not delete custom group ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted custom schema", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+}
+
+// ImportState accepts the ID of the custom group. All of its fields are
+// imported with it.
+func (r *CustomSchemaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Could not parse import ID as integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("fields"), types.ListNull(customSchemaFieldType))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("field_ids"), types.MapNull(types.Int64Type))...)
+}
+
+// rollback removes the fields and the custom group of a schema that could not
+// be created completely.
+func (r *CustomSchemaResource) rollback(ctx context.Context, groupID int64, fieldIDs []int64) {
+	for _, id := range fieldIDs {
+		if err := r.client.Delete(ctx, "CustomField", id); err != nil {
+			tflog.Warn(ctx, "Could not remove custom field after failed schema create", map[string]any{
+				"id":    id,
+				"error": err.Error(),
+			})
+		}
+	}
+
+	if err := r.client.Delete(ctx, "CustomGroup", groupID); err != nil {
+		tflog.Warn(ctx, "Could not remove custom group after failed schema create", map[string]any{
+			"id":    groupID,
+			"error": err.Error(),
+		})
+	}
+}
+
+// readFields loads all fields of the custom group in display order into
+// model. prior is used to keep optional values CiviCRM returns as empty.
+func (r *CustomSchemaResource) readFields(ctx context.Context, prior []CustomSchemaFieldModel, model *CustomSchemaResourceModel, diags *diag.Diagnostics) {
+	results, err := r.client.GetAll(ctx, "CustomField", [][]any{
+		{"custom_group_id", "=", model.ID.ValueInt64()},
+	}, []string{"id", "name", "label", "data_type", "html_type", "default_value", "option_group_id", "is_required", "is_searchable", "is_active"}, []string{"weight"})
+	if err != nil {
+		diags.AddError(
+			"Error reading custom schema",
+			"Could not read custom fields of custom group ID "+strconv.FormatInt(model.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	priorDefaults := make(map[string]types.String, len(prior))
+	for _, field := range prior {
+		priorDefaults[field.Name.ValueString()] = field.DefaultValue
+	}
+
+	fields := make([]CustomSchemaFieldModel, 0, len(results))
+	fieldIDs := make(map[string]int64, len(results))
+	for _, result := range results {
+		field := CustomSchemaFieldModel{
+			Name:          types.StringNull(),
+			Label:         types.StringNull(),
+			DataType:      types.StringNull(),
+			HtmlType:      types.StringNull(),
+			OptionGroupID: types.Int64Null(),
+			IsRequired:    types.BoolValue(false),
+			IsSearchable:  types.BoolValue(false),
+			IsActive:      types.BoolValue(true),
+		}
+
+		if name, ok := GetString(result, "name"); ok {
+			field.Name = types.StringValue(name)
+		}
+
+		if label, ok := GetString(result, "label"); ok {
+			field.Label = types.StringValue(label)
+		}
+
+		if dataType, ok := GetString(result, "data_type"); ok {
+			field.DataType = types.StringValue(dataType)
+		}
+
+		if htmlType, ok := GetString(result, "html_type"); ok {
+			field.HtmlType = types.StringValue(htmlType)
+		}
+
+		current, ok := priorDefaults[field.Name.ValueString()]
+		if !ok {
+			current = types.StringNull()
+		}
+		field.DefaultValue = r.client.optionalString(result, "default_value", current)
+
+		if optionGroupID, ok := GetInt64(result, "option_group_id"); ok {
+			field.OptionGroupID = types.Int64Value(optionGroupID)
+		}
+
+		if isRequired, ok := GetBool(result, "is_required"); ok {
+			field.IsRequired = types.BoolValue(isRequired)
+		}
+
+		if isSearchable, ok := GetBool(result, "is_searchable"); ok {
+			field.IsSearchable = types.BoolValue(isSearchable)
+		}
+
+		if isActive, ok := GetBool(result, "is_active"); ok {
+			field.IsActive = types.BoolValue(isActive)
+		}
+
+		if id, ok := GetInt64(result, "id"); ok {
+			fieldIDs[field.Name.ValueString()] = id
+		}
+
+		fields = append(fields, field)
+	}
+
+	fieldList, d := types.ListValueFrom(ctx, customSchemaFieldType, fields)
+	diags.Append(d...)
+	model.Fields = fieldList
+
+	idMap, d := types.MapValueFrom(ctx, types.Int64Type, fieldIDs)
+	diags.Append(d...)
+	model.FieldIDs = idMap
+}
+
+func (r *CustomSchemaResource) mapGroupToModel(result map[string]any, model *CustomSchemaResourceModel) {
+	if id, ok := GetInt64(result, "id"); ok {
+		model.ID = types.Int64Value(id)
+	}
+
+	if name, ok := GetString(result, "name"); ok {
+		model.Name = types.StringValue(name)
+	}
+
+	if title, ok := GetString(result, "title"); ok {
+		model.Title = types.StringValue(title)
+	}
+
+	if extends, ok := GetString(result, "extends"); ok {
+		model.Extends = types.StringValue(extends)
+	}
+
+	if style, ok := GetString(result, "style"); ok {
+		model.Style = types.StringValue(style)
+	}
+
+	if isMultiple, ok := GetBool(result, "is_multiple"); ok {
+		model.IsMultiple = types.BoolValue(isMultiple)
+	}
+
+	if isActive, ok := GetBool(result, "is_active"); ok {
+		model.IsActive = types.BoolValue(isActive)
+	}
+
+	if tableName, ok := GetString(result, "table_name"); ok {
+		model.TableName = types.StringValue(tableName)
+	} else if model.TableName.IsUnknown() {
+		model.TableName = types.StringNull()
+	}
+}
+
+// customSchemaFields returns the configured fields of model.
+func customSchemaFields(ctx context.Context, model CustomSchemaResourceModel, diags *diag.Diagnostics) []CustomSchemaFieldModel {
+	var fields []CustomSchemaFieldModel
+	diags.Append(model.Fields.ElementsAs(ctx, &fields, false)...)
+	return fields
+}
+
+// customSchemaFieldValues builds the API values for the field at position
+// index. On update, unset optional values are cleared.
+func customSchemaFieldValues(field CustomSchemaFieldModel, index int, update bool) map[string]any {
+	values := map[string]any{
+		"name":          field.Name.ValueString(),
+		"label":         field.Label.ValueString(),
+		"html_type":     field.HtmlType.ValueString(),
+		"is_required":   field.IsRequired.ValueBool(),
+		"is_searchable": field.IsSearchable.ValueBool(),
+		"is_active":     field.IsActive.ValueBool(),
+		"weight":        index + 1,
+	}
+
+	// The data type of an existing field cannot change, see Update
+	if !update {
+		values["data_type"] = field.DataType.ValueString()
+	}
+
+	if !field.DefaultValue.IsNull() {
+		values["default_value"] = field.DefaultValue.ValueString()
+	} else if update {
+		values["default_value"] = nil
+	}
+
+	if !field.OptionGroupID.IsNull() {
+		values["option_group_id"] = field.OptionGroupID.ValueInt64()
+	} else if update {
+		values["option_group_id"] = nil
+	}
+
+	return values
+}
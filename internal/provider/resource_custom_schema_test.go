@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCustomSchemaResourceValidateConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		style         string
+		isMultiple    types.Bool
+		fields        []string
+		wantStyleErr  bool
+		wantFieldsErr bool
+	}{
+		{name: "inline", style: "Inline", isMultiple: types.BoolValue(false), fields: []string{"skills"}},
+		{name: "tab", style: "Tab", isMultiple: types.BoolValue(false), fields: []string{"skills"}},
+		{name: "tab with table for multi-record", style: "Tab with table", isMultiple: types.BoolValue(true), fields: []string{"skills"}},
+		{name: "tab with table for single-record", style: "Tab with table", isMultiple: types.BoolValue(false), fields: []string{"skills"}, wantStyleErr: true},
+		{name: "tab with table by default", style: "Tab with table", isMultiple: types.BoolNull(), fields: []string{"skills"}, wantStyleErr: true},
+		{name: "tab with table for unknown", style: "Tab with table", isMultiple: types.BoolUnknown(), fields: []string{"skills"}},
+		{name: "duplicate field", style: "Inline", isMultiple: types.BoolValue(false), fields: []string{"skills", "skills"}, wantFieldsErr: true},
+		{name: "both", style: "Tab with table", isMultiple: types.BoolValue(false), fields: []string{"skills", "skills"}, wantStyleErr: true, wantFieldsErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			r := &CustomSchemaResource{}
+			s := newTestResource(t, r, nil)
+
+			fields := make([]CustomSchemaFieldModel, 0, len(tt.fields))
+			for _, name := range tt.fields {
+				fields = append(fields, CustomSchemaFieldModel{
+					Name:          types.StringValue(name),
+					Label:         types.StringValue("Skills"),
+					DataType:      types.StringValue("String"),
+					HtmlType:      types.StringValue("Text"),
+					DefaultValue:  types.StringNull(),
+					OptionGroupID: types.Int64Null(),
+					IsRequired:    types.BoolNull(),
+					IsSearchable:  types.BoolNull(),
+					IsActive:      types.BoolNull(),
+				})
+			}
+			fieldsList, diags := types.ListValueFrom(ctx, customSchemaFieldType, fields)
+			if diags.HasError() {
+				t.Fatalf("fields: %v", diags)
+			}
+
+			config := CustomSchemaResourceModel{
+				ID:         types.Int64Null(),
+				Name:       types.StringValue("volunteer_info"),
+				Title:      types.StringValue("Volunteer Info"),
+				Extends:    types.StringValue("Contact"),
+				Style:      types.StringValue(tt.style),
+				IsMultiple: tt.isMultiple,
+				IsActive:   types.BoolNull(),
+				TableName:  types.StringNull(),
+				Fields:     fieldsList,
+				FieldIDs:   types.MapNull(types.Int64Type),
+			}
+
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: testConfig(t, s, config)}, resp)
+
+			if got := hasAttributeDiagnostic(resp.Diagnostics, diag.SeverityError, "style"); got != tt.wantStyleErr {
+				t.Errorf("style error = %t, want %t: %v", got, tt.wantStyleErr, resp.Diagnostics)
+			}
+			if got := hasFieldNameDiagnostic(resp.Diagnostics); got != tt.wantFieldsErr {
+				t.Errorf("field name error = %t, want %t: %v", got, tt.wantFieldsErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// hasFieldNameDiagnostic reports whether diags holds an error for the name of
+// the second entry in fields.
+func hasFieldNameDiagnostic(diags diag.Diagnostics) bool {
+	for _, d := range diags.Errors() {
+		withPath, ok := d.(diag.DiagnosticWithPath)
+		if ok && withPath.Path().Equal(path.Root("fields").AtListIndex(1).AtName("name")) {
+			return true
+		}
+	}
+	return false
+}
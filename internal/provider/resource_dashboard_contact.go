@@ -0,0 +1,366 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &DashboardContactResource{}
+	_ resource.ResourceWithConfigure   = &DashboardContactResource{}
+	_ resource.ResourceWithImportState = &DashboardContactResource{}
+)
+
+// DashboardContactResource manages the placement of a dashlet on a contact's dashboard.
+type DashboardContactResource struct {
+	client *Client
+}
+
+type DashboardContactResourceModel struct {
+	ID          types.Int64 `tfsdk:"id"`
+	DashboardID types.Int64 `tfsdk:"dashboard_id"`
+	ContactID   types.Int64 `tfsdk:"contact_id"`
+	ColumnNo    types.Int64 `tfsdk:"column_no"`
+	IsActive    types.Bool  `tfsdk:"is_active"`
+	Weight      types.Int64 `tfsdk:"weight"`
+}
+
+// dashboardContactSelect selects the DashboardContact fields that
+// mapResponseToModel reads.
+var dashboardContactSelect = []string{"id", "dashboard_id", "contact_id", "column_no", "weight", "is_active"}
+
+func NewDashboardContactResource() resource.Resource {
+	return &DashboardContactResource{}
+}
+
+func (r *DashboardContactResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard_contact"
+}
+
+func (r *DashboardContactResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Places a CiviCRM dashlet on a contact's dashboard.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier of the dashboard placement.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"dashboard_id": schema.Int64Attribute{
+				Description: "The ID of the dashlet (Dashboard entity) to place.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"contact_id": schema.Int64Attribute{
+				Description: "The ID of the contact whose dashboard the dashlet is placed on.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"column_no": schema.Int64Attribute{
+				Description: "The dashboard column (0 for left, 1 for right). Default: 0.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+			},
+			"is_active": schema.BoolAttribute{
+				Description: "Whether the dashlet is shown on the dashboard. Default: true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"weight": schema.Int64Attribute{
+				Description: "The sort weight of the dashlet within its column. When not set, CiviCRM assigns the weight.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DashboardContactResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DashboardContactResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DashboardContactResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating dashboard contact", map[string]any{
+		"dashboard_id": plan.DashboardID.ValueInt64(),
+		"contact_id":   plan.ContactID.ValueInt64(),
+	})
+
+	// Build values for API call
+	values := map[string]any{
+		"dashboard_id": plan.DashboardID.ValueInt64(),
+		"contact_id":   plan.ContactID.ValueInt64(),
+		"column_no":    plan.ColumnNo.ValueInt64(),
+		"is_active":    plan.IsActive.ValueBool(),
+	}
+
+	if !plan.Weight.IsNull() && !plan.Weight.IsUnknown() {
+		values["weight"] = plan.Weight.ValueInt64()
+	}
+
+	// Call API
+	result, err := r.client.Create(ctx, "DashboardContact", values)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating dashboard contact",
+			"Could not create dashboard contact, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update state with response
+	r.mapResponseToModel(result, &plan)
+
+	tflog.Debug(ctx, "Created dashboard contact", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *DashboardContactResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DashboardContactResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading dashboard contact", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	result, err := r.client.GetByID(ctx, "DashboardContact", state.ID.ValueInt64(), dashboardContactSelect)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Dashboard contact no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading dashboard contact",
+			"Could not read dashboard contact ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	r.mapResponseToModel(result, &state)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *DashboardContactResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DashboardContactResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state DashboardContactResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating dashboard contact", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	// Build values for API call
+	values := map[string]any{
+		"column_no": plan.ColumnNo.ValueInt64(),
+		"is_active": plan.IsActive.ValueBool(),
+	}
+
+	// The planned weight is the prior one when not configured, which is
+	// not sent so that CiviCRM can reorder the dashlets
+	if weight, ok := configuredWeight(ctx, req.Config, &resp.Diagnostics); ok {
+		values["weight"] = weight
+	}
+
+	// Call API
+	result, err := r.client.Update(ctx, "DashboardContact", state.ID.ValueInt64(), values)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating dashboard contact",
+			"Could not update dashboard contact ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	plan.ID = state.ID
+	r.mapResponseToModel(result, &plan)
+
+	tflog.Debug(ctx, "Updated dashboard contact", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *DashboardContactResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DashboardContactResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting dashboard contact", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	err := r.client.Delete(ctx, "DashboardContact", state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting dashboard contact",
+			"Could not delete dashboard contact ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted dashboard contact", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+}
+
+// ImportState accepts an import ID of the form "dashboard_id/contact_id".
+func (r *DashboardContactResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Expected import ID in the format 'dashboard_id/contact_id', got: "+req.ID,
+		)
+		return
+	}
+
+	dashboardID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Could not parse dashboard_id as integer: "+err.Error(),
+		)
+		return
+	}
+
+	contactID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Could not parse contact_id as integer: "+err.Error(),
+		)
+		return
+	}
+
+	results, err := r.client.Get(ctx, "DashboardContact", [][]any{
+		{"dashboard_id", "=", dashboardID},
+		{"contact_id", "=", contactID},
+	}, []string{"id"})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing dashboard contact",
+			"Could not look up dashboard contact: "+err.Error(),
+		)
+		return
+	}
+
+	if len(results) == 0 {
+		resp.Diagnostics.AddError(
+			"Dashboard contact not found",
+			fmt.Sprintf("No dashboard placement found for dashboard_id %d and contact_id %d.", dashboardID, contactID),
+		)
+		return
+	}
+
+	id, ok := GetInt64(results[0], "id")
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing dashboard contact",
+			"Dashboard contact lookup returned no valid id.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (r *DashboardContactResource) mapResponseToModel(result map[string]any, model *DashboardContactResourceModel) {
+	if id, ok := GetInt64(result, "id"); ok {
+		model.ID = types.Int64Value(id)
+	}
+
+	if dashboardID, ok := GetInt64(result, "dashboard_id"); ok {
+		model.DashboardID = types.Int64Value(dashboardID)
+	}
+
+	if contactID, ok := GetInt64(result, "contact_id"); ok {
+		model.ContactID = types.Int64Value(contactID)
+	}
+
+	if columnNo, ok := GetInt64(result, "column_no"); ok {
+		model.ColumnNo = types.Int64Value(columnNo)
+	}
+
+	if isActive, ok := GetBool(result, "is_active"); ok {
+		model.IsActive = types.BoolValue(isActive)
+	}
+
+	if weight, ok := GetInt64(result, "weight"); ok {
+		model.Weight = types.Int64Value(weight)
+	} else if model.Weight.IsUnknown() {
+		model.Weight = types.Int64Null()
+	}
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDashboardContactResourceUnconfiguredWeight(t *testing.T) {
+	ctx := context.Background()
+
+	record := map[string]any{
+		"id": 21, "dashboard_id": 4, "contact_id": 2, "column_no": 0, "weight": 3, "is_active": true,
+	}
+	var sent map[string]any
+	client := newTestClient(t, func(req testRequest) ([]map[string]any, error) {
+		if req.Entity+"."+req.Action == "DashboardContact.update" {
+			sent, _ = req.Params["values"].(map[string]any)
+			maps.Copy(record, sent)
+			return []map[string]any{record}, nil
+		}
+		t.Errorf("unexpected request %s.%s", req.Entity, req.Action)
+		return nil, errors.New("unexpected request")
+	})
+
+	r := &DashboardContactResource{}
+	s := newTestResource(t, r, client)
+
+	prior := DashboardContactResourceModel{
+		ID:          types.Int64Value(21),
+		DashboardID: types.Int64Value(4),
+		ContactID:   types.Int64Value(2),
+		ColumnNo:    types.Int64Value(0),
+		IsActive:    types.BoolValue(true),
+		Weight:      types.Int64Value(3),
+	}
+	state := testState(t, s, prior)
+
+	config := prior
+	config.ID = types.Int64Null()
+	config.ColumnNo = types.Int64Value(1)
+	config.Weight = types.Int64Null()
+
+	// The weight is not planned as unknown when other attributes change
+	modifyReq := planmodifier.Int64Request{
+		Path:        path.Root("weight"),
+		Config:      testConfig(t, s, config),
+		State:       state,
+		ConfigValue: types.Int64Null(),
+		PlanValue:   types.Int64Unknown(),
+		StateValue:  prior.Weight,
+	}
+	modifyResp := &planmodifier.Int64Response{PlanValue: modifyReq.PlanValue}
+	for _, modifier := range s.Attributes["weight"].(schema.Int64Attribute).PlanModifiers {
+		modifier.PlanModifyInt64(ctx, modifyReq, modifyResp)
+	}
+	if !modifyResp.PlanValue.Equal(prior.Weight) {
+		t.Fatalf("planned weight = %s, want %s", modifyResp.PlanValue, prior.Weight)
+	}
+
+	plan := config
+	plan.ID = prior.ID
+	plan.Weight = modifyResp.PlanValue
+
+	planned := testPlan(t, s, plan)
+	updateResp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: planned, Config: testConfig(t, s, config), State: state}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Update: %v", updateResp.Diagnostics)
+	}
+	if _, ok := sent["weight"]; ok {
+		t.Errorf("update sent the unconfigured weight: %v", sent)
+	}
+	if !updateResp.State.Raw.Equal(planned.Raw) {
+		t.Errorf("Update produced an inconsistent result:\nplan:  %s\nstate: %s", planned.Raw, updateResp.State.Raw)
+	}
+}
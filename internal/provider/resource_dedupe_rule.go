@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &DedupeRuleResource{}
+	_ resource.ResourceWithConfigure   = &DedupeRuleResource{}
+	_ resource.ResourceWithImportState = &DedupeRuleResource{}
+)
+
+// DedupeRuleResource manages a single field comparison of a dedupe rule
+// group.
+type DedupeRuleResource struct {
+	client *Client
+}
+
+type DedupeRuleResourceModel struct {
+	ID                types.Int64  `tfsdk:"id"`
+	DedupeRuleGroupID types.Int64  `tfsdk:"dedupe_rule_group_id"`
+	RuleTable         types.String `tfsdk:"rule_table"`
+	RuleField         types.String `tfsdk:"rule_field"`
+	RuleLength        types.Int64  `tfsdk:"rule_length"`
+	RuleWeight        types.Int64  `tfsdk:"rule_weight"`
+}
+
+// dedupeRuleSelect selects the DedupeRule fields that mapResponseToModel
+// reads.
+var dedupeRuleSelect = []string{
+	"id", "dedupe_rule_group_id", "rule_table", "rule_field", "rule_length",
+	"rule_weight",
+}
+
+func NewDedupeRuleResource() resource.Resource {
+	return &DedupeRuleResource{}
+}
+
+func (r *DedupeRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dedupe_rule"
+}
+
+func (r *DedupeRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a rule of a CiviCRM dedupe rule group: a field that is compared between contacts and the weight a match adds.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier of the dedupe rule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"dedupe_rule_group_id": schema.Int64Attribute{
+				Description: "The ID of the dedupe rule group the rule belongs to. Changing this forces a new dedupe rule.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"rule_table": schema.StringAttribute{
+				Description: "The table of the compared field (e.g., 'civicrm_contact', 'civicrm_email', 'civicrm_address', or the table of a custom group). Default: 'civicrm_contact'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("civicrm_contact"),
+			},
+			"rule_field": schema.StringAttribute{
+				Description: "The column of the compared field (e.g., 'first_name', 'email', 'postal_code').",
+				Required:    true,
+			},
+			"rule_length": schema.Int64Attribute{
+				Description: "The number of leading characters that are compared. Compares the whole value when not set.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"rule_weight": schema.Int64Attribute{
+				Description: "The weight a match on this field adds towards the threshold of the rule group.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *DedupeRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DedupeRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DedupeRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating dedupe rule", map[string]any{
+		"dedupe_rule_group_id": plan.DedupeRuleGroupID.ValueInt64(),
+		"rule_field":           plan.RuleField.ValueString(),
+	})
+
+	// Call API
+	result, err := r.client.Create(ctx, "DedupeRule", r.buildValues(plan, false))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating dedupe rule",
+			"Could not create dedupe rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update state with response
+	r.mapResponseToModel(result, &plan)
+
+	tflog.Debug(ctx, "Created dedupe rule", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *DedupeRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DedupeRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading dedupe rule", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	result, err := r.client.GetByID(ctx, "DedupeRule", state.ID.ValueInt64(), dedupeRuleSelect)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Dedupe rule no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading dedupe rule",
+			"Could not read dedupe rule ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	r.mapResponseToModel(result, &state)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *DedupeRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DedupeRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state DedupeRuleResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating dedupe rule", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	// Call API
+	result, err := r.client.Update(ctx, "DedupeRule", state.ID.ValueInt64(), r.buildValues(plan, true))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating dedupe rule",
+			"Could not update dedupe rule ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	plan.ID = state.ID
+	r.mapResponseToModel(result, &plan)
+
+	tflog.Debug(ctx, "Updated dedupe rule", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *DedupeRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DedupeRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting dedupe rule", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	err := r.client.Delete(ctx, "DedupeRule", state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting dedupe rule",
+			"Could not delete dedupe rule ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted dedupe rule", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+}
+
+func (r *DedupeRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Could not parse import ID as integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// buildValues builds the API values for plan. On update, a null rule_length
+// is sent as nil so that it is cleared.
+func (r *DedupeRuleResource) buildValues(plan DedupeRuleResourceModel, update bool) map[string]any {
+	values := map[string]any{
+		"rule_table":  plan.RuleTable.ValueString(),
+		"rule_field":  plan.RuleField.ValueString(),
+		"rule_weight": plan.RuleWeight.ValueInt64(),
+	}
+
+	if !update {
+		values["dedupe_rule_group_id"] = plan.DedupeRuleGroupID.ValueInt64()
+	}
+
+	if !plan.RuleLength.IsNull() {
+		values["rule_length"] = plan.RuleLength.ValueInt64()
+	} else if update {
+		values["rule_length"] = nil
+	}
+
+	return values
+}
+
+func (r *DedupeRuleResource) mapResponseToModel(result map[string]any, model *DedupeRuleResourceModel) {
+	if id, ok := GetInt64(result, "id"); ok {
+		model.ID = types.Int64Value(id)
+	}
+
+	if groupID, ok := GetInt64(result, "dedupe_rule_group_id"); ok {
+		model.DedupeRuleGroupID = types.Int64Value(groupID)
+	}
+
+	if ruleTable, ok := GetString(result, "rule_table"); ok {
+		model.RuleTable = types.StringValue(ruleTable)
+	}
+
+	if ruleField, ok := GetString(result, "rule_field"); ok {
+		model.RuleField = types.StringValue(ruleField)
+	}
+
+	if ruleLength, ok := GetInt64(result, "rule_length"); ok {
+		model.RuleLength = types.Int64Value(ruleLength)
+	} else {
+		model.RuleLength = types.Int64Null()
+	}
+
+	if ruleWeight, ok := GetInt64(result, "rule_weight"); ok {
+		model.RuleWeight = types.Int64Value(ruleWeight)
+	}
+}
@@ -0,0 +1,341 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &DedupeRuleGroupResource{}
+	_ resource.ResourceWithConfigure   = &DedupeRuleGroupResource{}
+	_ resource.ResourceWithImportState = &DedupeRuleGroupResource{}
+	_ resource.ResourceWithModifyPlan  = &DedupeRuleGroupResource{}
+)
+
+// DedupeRuleGroupResource manages dedupe rule groups, the sets of rules
+// CiviCRM uses to find duplicate contacts.
+type DedupeRuleGroupResource struct {
+	client *Client
+}
+
+type DedupeRuleGroupResourceModel struct {
+	ID                   types.Int64  `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Title                types.String `tfsdk:"title"`
+	ContactType          types.String `tfsdk:"contact_type"`
+	Used                 types.String `tfsdk:"used"`
+	Threshold            types.Int64  `tfsdk:"threshold"`
+	IsReserved           types.Bool   `tfsdk:"is_reserved"`
+	AllowReservedChanges types.Bool   `tfsdk:"allow_reserved_changes"`
+}
+
+// dedupeRuleGroupSelect selects the DedupeRuleGroup fields that
+// mapResponseToModel reads.
+var dedupeRuleGroupSelect = []string{
+	"id", "name", "title", "contact_type", "threshold", "used", "is_reserved",
+}
+
+func NewDedupeRuleGroupResource() resource.Resource {
+	return &DedupeRuleGroupResource{}
+}
+
+func (r *DedupeRuleGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dedupe_rule_group"
+}
+
+func (r *DedupeRuleGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a CiviCRM dedupe rule group, the set of weighted rules used to find duplicate contacts. Add rules with civicrm_dedupe_rule.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier of the dedupe rule group.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The machine name of the dedupe rule group. Derived from the title when not set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "The display title of the dedupe rule group.",
+				Required:    true,
+			},
+			"contact_type": schema.StringAttribute{
+				Description: "The contact type the rules apply to. Options: 'Individual', 'Organization', 'Household'. Changing this forces a new dedupe rule group.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("Individual", "Organization", "Household"),
+				},
+			},
+			"used": schema.StringAttribute{
+				Description: "Where the rule group is used. Options: 'Unsupervised' (matching on forms such as event registrations), 'Supervised' (finding duplicates in the UI), 'General'.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Unsupervised", "Supervised", "General"),
+				},
+			},
+			"threshold": schema.Int64Attribute{
+				Description: "The total weight of matching rules at which two contacts are considered duplicates.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"is_reserved": schema.BoolAttribute{
+				Description: "Whether this is a reserved system dedupe rule group. Default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"allow_reserved_changes": allowReservedChangesAttribute,
+		},
+	}
+}
+
+func (r *DedupeRuleGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan protects reserved dedupe rule groups from accidental changes.
+func (r *DedupeRuleGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkReservedChange(ctx, req, resp, "dedupe rule group")
+}
+
+func (r *DedupeRuleGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DedupeRuleGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating dedupe rule group", map[string]any{
+		"title": plan.Title.ValueString(),
+	})
+
+	// Call API
+	result, err := r.client.Create(ctx, "DedupeRuleGroup", r.buildValues(plan, false))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating dedupe rule group",
+			"Could not create dedupe rule group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update state with response
+	r.mapResponseToModel(result, &plan)
+
+	tflog.Debug(ctx, "Created dedupe rule group", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *DedupeRuleGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DedupeRuleGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading dedupe rule group", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	result, err := r.client.GetByID(ctx, "DedupeRuleGroup", state.ID.ValueInt64(), dedupeRuleGroupSelect)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Dedupe rule group no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading dedupe rule group",
+			"Could not read dedupe rule group ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	r.mapResponseToModel(result, &state)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *DedupeRuleGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DedupeRuleGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state DedupeRuleGroupResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating dedupe rule group", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	// Call API
+	result, err := r.client.Update(ctx, "DedupeRuleGroup", state.ID.ValueInt64(), r.buildValues(plan, true))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating dedupe rule group",
+			"Could not update dedupe rule group ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	plan.ID = state.ID
+	r.mapResponseToModel(result, &plan)
+
+	tflog.Debug(ctx, "Updated dedupe rule group", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *DedupeRuleGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DedupeRuleGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting dedupe rule group", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	err := r.client.Delete(ctx, "DedupeRuleGroup", state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting dedupe rule group",
+			"Could not delete dedupe rule group ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted dedupe rule group", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+}
+
+func (r *DedupeRuleGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Could not parse import ID as integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("allow_reserved_changes"), false)...)
+}
+
+// buildValues builds the API values for plan. The name is only sent when it
+// is known, so that CiviCRM derives it from the title otherwise.
+func (r *DedupeRuleGroupResource) buildValues(plan DedupeRuleGroupResourceModel, update bool) map[string]any {
+	values := map[string]any{
+		"title":       plan.Title.ValueString(),
+		"used":        plan.Used.ValueString(),
+		"threshold":   plan.Threshold.ValueInt64(),
+		"is_reserved": plan.IsReserved.ValueBool(),
+	}
+
+	if !update {
+		values["contact_type"] = plan.ContactType.ValueString()
+	}
+
+	if !plan.Name.IsNull() && !plan.Name.IsUnknown() {
+		values["name"] = plan.Name.ValueString()
+	}
+
+	return values
+}
+
+func (r *DedupeRuleGroupResource) mapResponseToModel(result map[string]any, model *DedupeRuleGroupResourceModel) {
+	if id, ok := GetInt64(result, "id"); ok {
+		model.ID = types.Int64Value(id)
+	}
+
+	if name, ok := GetString(result, "name"); ok {
+		model.Name = types.StringValue(name)
+	} else if model.Name.IsUnknown() {
+		model.Name = types.StringNull()
+	}
+
+	if title, ok := GetString(result, "title"); ok {
+		model.Title = types.StringValue(title)
+	}
+
+	if contactType, ok := GetString(result, "contact_type"); ok {
+		model.ContactType = types.StringValue(contactType)
+	}
+
+	if used, ok := GetString(result, "used"); ok {
+		model.Used = types.StringValue(used)
+	}
+
+	if threshold, ok := GetInt64(result, "threshold"); ok {
+		model.Threshold = types.Int64Value(threshold)
+	}
+
+	if isReserved, ok := GetBool(result, "is_reserved"); ok {
+		model.IsReserved = types.BoolValue(isReserved)
+	}
+}
@@ -0,0 +1,345 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &EmailResource{}
+	_ resource.ResourceWithConfigure   = &EmailResource{}
+	_ resource.ResourceWithImportState = &EmailResource{}
+)
+
+// EmailResource manages email addresses of contacts, such as the addresses
+// of the contacts CiviCRM uses for system mail and bounce processing.
+type EmailResource struct {
+	client *Client
+}
+
+type EmailResourceModel struct {
+	ID             types.Int64  `tfsdk:"id"`
+	ContactID      types.Int64  `tfsdk:"contact_id"`
+	Email          types.String `tfsdk:"email"`
+	LocationTypeID types.Int64  `tfsdk:"location_type_id"`
+	IsPrimary      types.Bool   `tfsdk:"is_primary"`
+	IsBilling      types.Bool   `tfsdk:"is_billing"`
+	OnHold         types.Int64  `tfsdk:"on_hold"`
+}
+
+// emailSelect selects the Email fields that mapResponseToModel reads.
+var emailSelect = []string{
+	"id", "contact_id", "location_type_id", "email", "is_primary", "is_billing",
+	"on_hold",
+}
+
+func NewEmailResource() resource.Resource {
+	return &EmailResource{}
+}
+
+func (r *EmailResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email"
+}
+
+func (r *EmailResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an email address of a CiviCRM contact.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier of the email record.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"contact_id": schema.Int64Attribute{
+				Description: "The ID of the contact the email address belongs to. Changing this forces a new email record.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "The email address.",
+				Required:    true,
+			},
+			"location_type_id": schema.Int64Attribute{
+				Description: "The location type of the email address (e.g., Home, Work). Defaults to the default location type of the site.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_primary": schema.BoolAttribute{
+				Description: "Whether this is the primary email address of the contact. CiviCRM makes the first email address of a contact primary when this is not set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_billing": schema.BoolAttribute{
+				Description: "Whether this is the billing email address of the contact. Default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"on_hold": schema.Int64Attribute{
+				Description: "Whether mail to the address is on hold. Options: 0 (not on hold), 1 (on hold after bounces), 2 (opted out). Default: 0.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.OneOf(0, 1, 2),
+				},
+			},
+		},
+	}
+}
+
+func (r *EmailResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *EmailResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan EmailResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating email", map[string]any{
+		"contact_id": plan.ContactID.ValueInt64(),
+		"email":      plan.Email.ValueString(),
+	})
+
+	// Build values for API call
+	values := map[string]any{
+		"contact_id": plan.ContactID.ValueInt64(),
+		"email":      plan.Email.ValueString(),
+		"is_billing": plan.IsBilling.ValueBool(),
+		"on_hold":    plan.OnHold.ValueInt64(),
+	}
+
+	if !plan.LocationTypeID.IsNull() && !plan.LocationTypeID.IsUnknown() {
+		values["location_type_id"] = plan.LocationTypeID.ValueInt64()
+	}
+
+	if !plan.IsPrimary.IsNull() && !plan.IsPrimary.IsUnknown() {
+		values["is_primary"] = plan.IsPrimary.ValueBool()
+	}
+
+	// Call API
+	result, err := r.client.Create(ctx, "Email", values)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating email",
+			"Could not create email, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update state with response
+	r.mapResponseToModel(result, &plan)
+
+	tflog.Debug(ctx, "Created email", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *EmailResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state EmailResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading email", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	result, err := r.client.GetByID(ctx, "Email", state.ID.ValueInt64(), emailSelect)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Email no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading email",
+			"Could not read email ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	r.mapResponseToModel(result, &state)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *EmailResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan EmailResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state EmailResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating email", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	// Build values for API call
+	values := map[string]any{
+		"email":      plan.Email.ValueString(),
+		"is_billing": plan.IsBilling.ValueBool(),
+		"on_hold":    plan.OnHold.ValueInt64(),
+	}
+
+	if !plan.LocationTypeID.IsNull() && !plan.LocationTypeID.IsUnknown() {
+		values["location_type_id"] = plan.LocationTypeID.ValueInt64()
+	}
+
+	if !plan.IsPrimary.IsNull() && !plan.IsPrimary.IsUnknown() {
+		values["is_primary"] = plan.IsPrimary.ValueBool()
+	}
+
+	// Call API
+	result, err := r.client.Update(ctx, "Email", state.ID.ValueInt64(), values)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating email",
+			"Could not update email ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	plan.ID = state.ID
+	r.mapResponseToModel(result, &plan)
+
+	tflog.Debug(ctx, "Updated email", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *EmailResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state EmailResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting email", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	err := r.client.Delete(ctx, "Email", state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting email",
+			"Could not delete email ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted email", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+}
+
+func (r *EmailResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Could not parse import ID as integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (r *EmailResource) mapResponseToModel(result map[string]any, model *EmailResourceModel) {
+	if id, ok := GetInt64(result, "id"); ok {
+		model.ID = types.Int64Value(id)
+	}
+
+	if contactID, ok := GetInt64(result, "contact_id"); ok {
+		model.ContactID = types.Int64Value(contactID)
+	}
+
+	if email, ok := GetString(result, "email"); ok {
+		model.Email = types.StringValue(email)
+	}
+
+	if locationTypeID, ok := GetInt64(result, "location_type_id"); ok {
+		model.LocationTypeID = types.Int64Value(locationTypeID)
+	} else if model.LocationTypeID.IsUnknown() {
+		model.LocationTypeID = types.Int64Null()
+	}
+
+	if isPrimary, ok := GetBool(result, "is_primary"); ok {
+		model.IsPrimary = types.BoolValue(isPrimary)
+	} else if model.IsPrimary.IsUnknown() {
+		model.IsPrimary = types.BoolValue(false)
+	}
+
+	if isBilling, ok := GetBool(result, "is_billing"); ok {
+		model.IsBilling = types.BoolValue(isBilling)
+	}
+
+	if onHold, ok := GetInt64(result, "on_hold"); ok {
+		model.OnHold = types.Int64Value(onHold)
+	}
+}
@@ -0,0 +1,310 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &EntityFinancialAccountResource{}
+	_ resource.ResourceWithConfigure   = &EntityFinancialAccountResource{}
+	_ resource.ResourceWithImportState = &EntityFinancialAccountResource{}
+)
+
+// EntityFinancialAccountResource links a financial type (or another financial
+// entity) to the financial account used for one account relationship.
+type EntityFinancialAccountResource struct {
+	client *Client
+}
+
+type EntityFinancialAccountResourceModel struct {
+	ID                  types.Int64  `tfsdk:"id"`
+	EntityTable         types.String `tfsdk:"entity_table"`
+	EntityID            types.Int64  `tfsdk:"entity_id"`
+	AccountRelationship types.String `tfsdk:"account_relationship"`
+	FinancialAccountID  types.Int64  `tfsdk:"financial_account_id"`
+}
+
+func NewEntityFinancialAccountResource() resource.Resource {
+	return &EntityFinancialAccountResource{}
+}
+
+func (r *EntityFinancialAccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entity_financial_account"
+}
+
+func (r *EntityFinancialAccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Links a CiviCRM financial type to the financial account used for an account relationship, such as the income or accounts receivable account.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier of the entity financial account link.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"entity_table": schema.StringAttribute{
+				Description: "The type of the linked entity. Default: 'civicrm_financial_type'. Changing this forces a new link.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("civicrm_financial_type"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_id": schema.Int64Attribute{
+				Description: "The ID of the linked entity, e.g. the financial type ID. Changing this forces a new link.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"account_relationship": schema.StringAttribute{
+				Description: "The name of the account relationship (e.g., 'Income Account is', 'Accounts Receivable Account is', 'Expense Account is', 'Cost of Sales Account is').",
+				Required:    true,
+			},
+			"financial_account_id": schema.Int64Attribute{
+				Description: "The ID of the financial account used for the relationship.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *EntityFinancialAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *EntityFinancialAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan EntityFinancialAccountResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating entity financial account", map[string]any{
+		"entity_table":         plan.EntityTable.ValueString(),
+		"entity_id":            plan.EntityID.ValueInt64(),
+		"account_relationship": plan.AccountRelationship.ValueString(),
+	})
+
+	// Build values for API call
+	values := map[string]any{
+		"entity_table":              plan.EntityTable.ValueString(),
+		"entity_id":                 plan.EntityID.ValueInt64(),
+		"account_relationship:name": plan.AccountRelationship.ValueString(),
+		"financial_account_id":      plan.FinancialAccountID.ValueInt64(),
+	}
+
+	// Call API
+	result, err := r.client.Create(ctx, "EntityFinancialAccount", values)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating entity financial account",
+			"Could not create entity financial account, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// The create response has the relationship ID, not its name
+	id, _ := GetInt64(result, "id")
+	result, err = r.client.GetByID(ctx, "EntityFinancialAccount", id, entityFinancialAccountSelect)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating entity financial account",
+			"Could not read created entity financial account ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state with response
+	r.mapResponseToModel(result, &plan)
+
+	tflog.Debug(ctx, "Created entity financial account", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *EntityFinancialAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state EntityFinancialAccountResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading entity financial account", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	result, err := r.client.GetByID(ctx, "EntityFinancialAccount", state.ID.ValueInt64(), entityFinancialAccountSelect)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Entity financial account no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading entity financial account",
+			"Could not read entity financial account ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	r.mapResponseToModel(result, &state)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *EntityFinancialAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan EntityFinancialAccountResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state EntityFinancialAccountResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating entity financial account", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	values := map[string]any{
+		"account_relationship:name": plan.AccountRelationship.ValueString(),
+		"financial_account_id":      plan.FinancialAccountID.ValueInt64(),
+	}
+
+	// Call API
+	if _, err := r.client.Update(ctx, "EntityFinancialAccount", state.ID.ValueInt64(), values); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating entity financial account",
+			"Could not update entity financial account ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	result, err := r.client.GetByID(ctx, "EntityFinancialAccount", state.ID.ValueInt64(), entityFinancialAccountSelect)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating entity financial account",
+			"Could not read entity financial account ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	plan.ID = state.ID
+	r.mapResponseToModel(result, &plan)
+
+	tflog.Debug(ctx, "Updated entity financial account", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *EntityFinancialAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state EntityFinancialAccountResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting entity financial account", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	err := r.client.Delete(ctx, "EntityFinancialAccount", state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting entity financial account",
+			"Could not delete entity financial account ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted entity financial account", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+}
+
+func (r *EntityFinancialAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Could not parse import ID as integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// entityFinancialAccountSelect selects the fields of an entity financial
+// account with the name of its account relationship.
+var entityFinancialAccountSelect = []string{"id", "entity_table", "entity_id", "account_relationship:name", "financial_account_id"}
+
+func (r *EntityFinancialAccountResource) mapResponseToModel(result map[string]any, model *EntityFinancialAccountResourceModel) {
+	if id, ok := GetInt64(result, "id"); ok {
+		model.ID = types.Int64Value(id)
+	}
+
+	if entityTable, ok := GetString(result, "entity_table"); ok {
+		model.EntityTable = types.StringValue(entityTable)
+	}
+
+	if entityID, ok := GetInt64(result, "entity_id"); ok {
+		model.EntityID = types.Int64Value(entityID)
+	}
+
+	if relationship, ok := GetString(result, "account_relationship:name"); ok {
+		model.AccountRelationship = types.StringValue(relationship)
+	}
+
+	if financialAccountID, ok := GetInt64(result, "financial_account_id"); ok {
+		model.FinancialAccountID = types.Int64Value(financialAccountID)
+	}
+}
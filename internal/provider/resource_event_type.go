@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &EventTypeResource{}
+	_ resource.ResourceWithConfigure      = &EventTypeResource{}
+	_ resource.ResourceWithImportState    = &EventTypeResource{}
+	_ resource.ResourceWithValidateConfig = &EventTypeResource{}
+)
+
+// EventTypeResource manages event types in CiviCRM.
+// Event types are stored as OptionValues in the "event_type" option group.
+type EventTypeResource struct {
+	optionValues optionValueCRUD
+}
+
+func NewEventTypeResource() resource.Resource {
+	return &EventTypeResource{
+		optionValues: optionValueCRUD{optionGroup: "event_type", noun: "event type", configurableValue: true},
+	}
+}
+
+func (r *EventTypeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_event_type"
+}
+
+func (r *EventTypeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a CiviCRM event type, which categorizes events (e.g., Conference, Workshop). Events reference the type by its value.",
+		Attributes:  r.optionValues.schemaAttributes(),
+	}
+}
+
+func (r *EventTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.optionValues.client = client
+}
+
+// ValidateConfig rejects ignore_fields entries that name no attribute or one
+// that cannot be ignored.
+func (r *EventTypeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateIgnoredFields(ctx, req.Config, &resp.Diagnostics)
+}
+
+func (r *EventTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan optionValueModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating event type", map[string]any{
+		"name":  plan.Name.ValueString(),
+		"label": plan.Label.ValueString(),
+	})
+
+	weight := configuredOptionValueWeight(ctx, req.Config, &resp.Diagnostics)
+	if err := r.optionValues.create(ctx, &plan, weight, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating event type",
+			"Could not create event type, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Created event type", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *EventTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state optionValueModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading event type", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	err := r.optionValues.read(ctx, &state, nil)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Event type no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading event type",
+			"Could not read event type ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+
+	// Keep the prior value of attributes managed outside of Terraform
+	ignored := ignoredFields(ctx, state.IgnoreFields, &resp.Diagnostics)
+	keepIgnoredFields(ctx, ignored, req.State, &resp.State, &resp.Diagnostics)
+}
+
+func (r *EventTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan optionValueModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state optionValueModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating event type", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	weight := configuredOptionValueWeight(ctx, req.Config, &resp.Diagnostics)
+	ignored := ignoredFields(ctx, plan.IgnoreFields, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.optionValues.update(ctx, state.ID.ValueInt64(), &plan, weight, nil, ignored); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating event type",
+			"Could not update event type ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Updated event type", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	keepIgnoredFields(ctx, ignored, req.Plan, &resp.State, &resp.Diagnostics)
+}
+
+func (r *EventTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state optionValueModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting event type", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	if err := r.optionValues.delete(ctx, state.ID.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting event type",
+			"Could not delete event type ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted event type", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+}
+
+func (r *EventTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Could not parse import ID as integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
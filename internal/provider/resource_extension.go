@@ -0,0 +1,386 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &ExtensionResource{}
+	_ resource.ResourceWithConfigure   = &ExtensionResource{}
+	_ resource.ResourceWithImportState = &ExtensionResource{}
+)
+
+// ExtensionResource ensures that a CiviCRM extension is installed and enabled
+// or disabled. Extensions are identified by their key and are managed through
+// the API v3 Extension actions.
+type ExtensionResource struct {
+	client *Client
+}
+
+type ExtensionResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Key                types.String `tfsdk:"key"`
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	Version            types.String `tfsdk:"version"`
+	Download           types.Bool   `tfsdk:"download"`
+	URL                types.String `tfsdk:"url"`
+	UninstallOnDestroy types.Bool   `tfsdk:"uninstall_on_destroy"`
+	Label              types.String `tfsdk:"label"`
+	Status             types.String `tfsdk:"status"`
+}
+
+func NewExtensionResource() resource.Resource {
+	return &ExtensionResource{}
+}
+
+func (r *ExtensionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_extension"
+}
+
+func (r *ExtensionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Ensures that a CiviCRM extension is installed, and optionally downloaded and upgraded. Destroying the resource disables the extension.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier of the extension (same as key).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "The key of the extension (e.g., 'org.civicrm.search_kit' or 'mosaico'). Changing this forces a new resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the extension is enabled. When false, the extension is installed but disabled. Default: true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"version": schema.StringAttribute{
+				Description: "The version of the extension. When set, a different installed version is replaced by downloading this version, which requires download = true. When not set, the installed version is exported.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"download": schema.BoolAttribute{
+				Description: "Whether the extension is downloaded when it is missing on the server or its version differs from version. Default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"url": schema.StringAttribute{
+				Description: "The URL of the zip file the extension is downloaded from. Defaults to the latest compatible release in the CiviCRM extension directory.",
+				Optional:    true,
+			},
+			"uninstall_on_destroy": schema.BoolAttribute{
+				Description: "Whether destroying the resource also uninstalls the extension, which deletes its data. Default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"label": schema.StringAttribute{
+				Description: "The display label of the extension.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the extension on the server (e.g., 'installed', 'disabled').",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *ExtensionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ExtensionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ExtensionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating extension", map[string]any{
+		"key": plan.Key.ValueString(),
+	})
+
+	result, err := r.ensure(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating extension",
+			"Could not install extension "+plan.Key.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update state with response
+	r.mapResponseToModel(result, &plan)
+
+	tflog.Debug(ctx, "Created extension", map[string]any{
+		"id":     plan.ID.ValueString(),
+		"status": plan.Status.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ExtensionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ExtensionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading extension", map[string]any{
+		"id": state.ID.ValueString(),
+	})
+
+	result, err := r.client.GetExtension(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading extension",
+			"Could not read extension "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// An extension that was uninstalled or removed outside of Terraform has to
+	// be installed again
+	if status, _ := GetString(result, "status"); status != "installed" && status != "disabled" {
+		tflog.Warn(ctx, "Extension is no longer installed, removing from state", map[string]any{
+			"id":     state.ID.ValueString(),
+			"status": status,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update state
+	r.mapResponseToModel(result, &state)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ExtensionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ExtensionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating extension", map[string]any{
+		"id": plan.Key.ValueString(),
+	})
+
+	result, err := r.ensure(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating extension",
+			"Could not update extension "+plan.Key.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	r.mapResponseToModel(result, &plan)
+
+	tflog.Debug(ctx, "Updated extension", map[string]any{
+		"id":     plan.ID.ValueString(),
+		"status": plan.Status.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ExtensionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ExtensionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting extension", map[string]any{
+		"id":        state.ID.ValueString(),
+		"uninstall": state.UninstallOnDestroy.ValueBool(),
+	})
+
+	result, err := r.client.GetExtension(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting extension",
+			"Could not read extension "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Extensions must be disabled before they can be uninstalled
+	status, _ := GetString(result, "status")
+	if status == "installed" {
+		if err := r.client.ChangeExtension(ctx, "disable", state.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting extension",
+				"Could not disable extension "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		status = "disabled"
+	}
+
+	if state.UninstallOnDestroy.ValueBool() && status == "disabled" {
+		if err := r.client.ChangeExtension(ctx, "uninstall", state.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting extension",
+				"Could not uninstall extension "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Deleted extension", map[string]any{
+		"id": state.ID.ValueString(),
+	})
+}
+
+// ImportState accepts the key of the extension.
+func (r *ExtensionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("download"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uninstall_on_destroy"), false)...)
+}
+
+// ensure brings the extension on the server to the state described by plan:
+// downloaded in the requested version, installed, and enabled or disabled. It
+// returns the extension as read after the changes.
+func (r *ExtensionResource) ensure(ctx context.Context, plan ExtensionResourceModel) (map[string]any, error) {
+	key := plan.Key.ValueString()
+
+	current, err := r.client.GetExtension(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	status, _ := GetString(current, "status")
+	version, _ := GetString(current, "version")
+
+	missing := status == "unknown" || status == "installed-missing" || status == "disabled-missing"
+	outdated := !missing && plan.Version.ValueString() != "" && plan.Version.ValueString() != version
+
+	if missing || outdated {
+		if !plan.Download.ValueBool() {
+			if missing {
+				return nil, fmt.Errorf("extension %s is not available on the server; set download = true to download it", key)
+			}
+			return nil, fmt.Errorf("extension %s has version %s instead of %s; set download = true to download the requested version", key, version, plan.Version.ValueString())
+		}
+
+		tflog.Debug(ctx, "Downloading extension", map[string]any{
+			"key":              key,
+			"url":              plan.URL.ValueString(),
+			"previous_version": version,
+		})
+
+		if err := r.client.DownloadExtension(ctx, key, plan.URL.ValueString()); err != nil {
+			return nil, fmt.Errorf("could not download extension: %w", err)
+		}
+
+		current, err = r.client.GetExtension(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		status, _ = GetString(current, "status")
+
+		if downloaded, _ := GetString(current, "version"); plan.Version.ValueString() != "" && downloaded != plan.Version.ValueString() {
+			return nil, fmt.Errorf("downloaded version %s of extension %s instead of %s; set url to the release of the requested version", downloaded, key, plan.Version.ValueString())
+		}
+	}
+
+	if status == "uninstalled" || status == "unknown" {
+		if err := r.client.ChangeExtension(ctx, "install", key); err != nil {
+			return nil, fmt.Errorf("could not install extension: %w", err)
+		}
+		status = "installed"
+	} else if outdated {
+		// New code of an installed extension may come with database upgrades
+		if err := r.client.UpgradeExtensions(ctx); err != nil {
+			return nil, fmt.Errorf("could not run extension upgrades: %w", err)
+		}
+	}
+
+	switch {
+	case plan.Enabled.ValueBool() && status == "disabled":
+		if err := r.client.ChangeExtension(ctx, "enable", key); err != nil {
+			return nil, fmt.Errorf("could not enable extension: %w", err)
+		}
+	case !plan.Enabled.ValueBool() && status == "installed":
+		if err := r.client.ChangeExtension(ctx, "disable", key); err != nil {
+			return nil, fmt.Errorf("could not disable extension: %w", err)
+		}
+	}
+
+	return r.client.GetExtension(ctx, key)
+}
+
+func (r *ExtensionResource) mapResponseToModel(result map[string]any, model *ExtensionResourceModel) {
+	if key, ok := GetString(result, "key"); ok {
+		model.ID = types.StringValue(key)
+		model.Key = types.StringValue(key)
+	}
+
+	if status, ok := GetString(result, "status"); ok {
+		model.Status = types.StringValue(status)
+		model.Enabled = types.BoolValue(status == "installed")
+	}
+
+	if version, ok := GetString(result, "version"); ok {
+		model.Version = types.StringValue(version)
+	} else if model.Version.IsUnknown() {
+		model.Version = types.StringNull()
+	}
+
+	if label, ok := GetString(result, "label"); ok {
+		model.Label = types.StringValue(label)
+	} else if model.Label.IsUnknown() {
+		model.Label = types.StringNull()
+	}
+}
@@ -2,9 +2,11 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,9 +19,10 @@ import (
 )
 
 var (
-	_ resource.Resource                = &GroupResource{}
-	_ resource.ResourceWithConfigure   = &GroupResource{}
-	_ resource.ResourceWithImportState = &GroupResource{}
+	_ resource.Resource                   = &GroupResource{}
+	_ resource.ResourceWithConfigure      = &GroupResource{}
+	_ resource.ResourceWithImportState    = &GroupResource{}
+	_ resource.ResourceWithValidateConfig = &GroupResource{}
 )
 
 // Group type mappings between human-readable names and CiviCRM API values
@@ -33,26 +36,65 @@ var groupTypeIDToName = map[string]string{
 	"2": "Mailing List",
 }
 
-// convertGroupTypesToIDs converts human-readable group type names to API IDs
-func convertGroupTypesToIDs(names []string) []string {
+// convertGroupTypesToIDs converts human-readable group type names to API IDs.
+// Names that are not built in are looked up in the group_type option group, so
+// that group types created with civicrm_group_type can be used.
+func (r *GroupResource) convertGroupTypesToIDs(ctx context.Context, names []string) ([]string, error) {
 	ids := make([]string, 0, len(names))
 	for _, name := range names {
 		if id, ok := groupTypeNameToID[name]; ok {
 			ids = append(ids, id)
+			continue
 		}
+
+		id, err := r.lookupGroupType(ctx, "name", name, "value")
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
 	}
-	return ids
+	return ids, nil
 }
 
 // convertGroupTypeIDsToNames converts API IDs to human-readable group type names
-func convertGroupTypeIDsToNames(ids []string) []string {
+func (r *GroupResource) convertGroupTypeIDsToNames(ctx context.Context, ids []string) ([]string, error) {
 	names := make([]string, 0, len(ids))
 	for _, id := range ids {
 		if name, ok := groupTypeIDToName[id]; ok {
 			names = append(names, name)
+			continue
+		}
+
+		name, err := r.lookupGroupType(ctx, "value", id, "name")
+		if err != nil {
+			return nil, err
 		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// lookupGroupType finds the group type whose field equals match and returns
+// its result field.
+func (r *GroupResource) lookupGroupType(ctx context.Context, field, match, result string) (string, error) {
+	results, err := r.client.Get(ctx, "OptionValue", [][]any{
+		{"option_group_id:name", "=", "group_type"},
+		{field, "=", match},
+	}, []string{result})
+	if err != nil {
+		return "", fmt.Errorf("failed to look up group type '%s': %w", match, err)
 	}
-	return names
+
+	if len(results) == 0 {
+		return "", fmt.Errorf("group type '%s' not found", match)
+	}
+
+	value, ok := GetString(results[0], result)
+	if !ok {
+		return "", fmt.Errorf("group type '%s' has no valid %s", match, result)
+	}
+
+	return value, nil
 }
 
 type GroupResource struct {
@@ -72,6 +114,14 @@ type GroupResourceModel struct {
 	FrontendTitle       types.String `tfsdk:"frontend_title"`
 	FrontendDescription types.String `tfsdk:"frontend_description"`
 	Parents             types.List   `tfsdk:"parents"`
+	IgnoreFields        types.List   `tfsdk:"ignore_fields"`
+}
+
+// groupSelect selects the Group fields that mapResponseToModel reads. Listing
+// them keeps computed fields such as the contact count out of refreshes.
+var groupSelect = []string{
+	"id", "name", "title", "description", "frontend_title", "frontend_description",
+	"is_active", "visibility", "group_type", "is_hidden", "is_reserved", "parents",
 }
 
 func NewGroupResource() resource.Resource {
@@ -118,7 +168,7 @@ func (r *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Default:     stringdefault.StaticString("User and User Admin Only"),
 			},
 			"group_type": schema.ListAttribute{
-				Description: "The types of the group. Valid values: 'Access Control', 'Mailing List'.",
+				Description: "The types of the group. Built-in values: 'Access Control', 'Mailing List'. Names of group types created with civicrm_group_type are also accepted.",
 				Optional:    true,
 				ElementType: types.StringType,
 			},
@@ -149,6 +199,7 @@ func (r *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:    true,
 				ElementType: types.Int64Type,
 			},
+			"ignore_fields": ignoreFieldsAttribute,
 		},
 	}
 }
@@ -170,6 +221,12 @@ func (r *GroupResource) Configure(ctx context.Context, req resource.ConfigureReq
 	r.client = client
 }
 
+// ValidateConfig rejects ignore_fields entries that name no attribute or one
+// that cannot be ignored.
+func (r *GroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateIgnoredFields(ctx, req.Config, &resp.Diagnostics)
+}
+
 func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan GroupResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -205,7 +262,15 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 			return
 		}
 		// Convert human-readable names to API IDs
-		values["group_type"] = convertGroupTypesToIDs(groupTypes)
+		groupTypeIDs, err := r.convertGroupTypesToIDs(ctx, groupTypes)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error resolving group types",
+				"Could not resolve group_type: "+err.Error(),
+			)
+			return
+		}
+		values["group_type"] = groupTypeIDs
 	}
 
 	if !plan.FrontendTitle.IsNull() {
@@ -227,7 +292,7 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Call API
-	result, err := r.client.Create("Group", values)
+	result, err := r.client.Create(ctx, "Group", values)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating group",
@@ -237,90 +302,9 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Update state with response
-	if id, ok := GetInt64(result, "id"); ok {
-		plan.ID = types.Int64Value(id)
-	}
-
-	if name, ok := GetString(result, "name"); ok {
-		plan.Name = types.StringValue(name)
-	}
-
-	if title, ok := GetString(result, "title"); ok {
-		plan.Title = types.StringValue(title)
-	}
-
-	if desc, ok := GetString(result, "description"); ok {
-		plan.Description = types.StringValue(desc)
-	} else {
-		plan.Description = types.StringNull()
-	}
-
-	if active, ok := GetBool(result, "is_active"); ok {
-		plan.IsActive = types.BoolValue(active)
-	}
-
-	if visibility, ok := GetString(result, "visibility"); ok {
-		plan.Visibility = types.StringValue(visibility)
-	}
-
-	// Handle group_type from API response
-	if groupTypeRaw, ok := result["group_type"]; ok && groupTypeRaw != nil {
-		if groupTypeSlice, ok := groupTypeRaw.([]any); ok {
-			ids := make([]string, 0, len(groupTypeSlice))
-			for _, v := range groupTypeSlice {
-				if s, ok := v.(string); ok {
-					ids = append(ids, s)
-				}
-			}
-			names := convertGroupTypeIDsToNames(ids)
-			groupTypeList, diags := types.ListValueFrom(ctx, types.StringType, names)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				plan.GroupType = groupTypeList
-			}
-		}
-	}
-
-	if hidden, ok := GetBool(result, "is_hidden"); ok {
-		plan.IsHidden = types.BoolValue(hidden)
-	}
-
-	if reserved, ok := GetBool(result, "is_reserved"); ok {
-		plan.IsReserved = types.BoolValue(reserved)
-	}
-
-	if frontendTitle, ok := GetString(result, "frontend_title"); ok && frontendTitle != "" {
-		plan.FrontendTitle = types.StringValue(frontendTitle)
-	} else {
-		plan.FrontendTitle = types.StringNull()
-	}
-
-	if frontendDesc, ok := GetString(result, "frontend_description"); ok && frontendDesc != "" {
-		plan.FrontendDescription = types.StringValue(frontendDesc)
-	} else {
-		plan.FrontendDescription = types.StringNull()
-	}
-
-	// Handle parents from API response
-	if parentsRaw, ok := result["parents"]; ok && parentsRaw != nil {
-		if parentsSlice, ok := parentsRaw.([]any); ok {
-			parentIDs := make([]int64, 0, len(parentsSlice))
-			for _, v := range parentsSlice {
-				if id, ok := v.(float64); ok {
-					parentIDs = append(parentIDs, int64(id))
-				} else if id, ok := v.(int64); ok {
-					parentIDs = append(parentIDs, id)
-				}
-			}
-			if len(parentIDs) > 0 {
-				parentsList, diags := types.ListValueFrom(ctx, types.Int64Type, parentIDs)
-				resp.Diagnostics.Append(diags...)
-				if !resp.Diagnostics.HasError() {
-					plan.Parents = parentsList
-				}
-			}
-		}
-	}
+	var d diag.Diagnostics
+	r.mapResponseToModel(ctx, result, &plan, &d)
+	resp.Diagnostics.Append(d...)
 
 	tflog.Debug(ctx, "Created group", map[string]any{
 		"id": plan.ID.ValueInt64(),
@@ -342,7 +326,14 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		"id": state.ID.ValueInt64(),
 	})
 
-	result, err := r.client.GetByID("Group", state.ID.ValueInt64(), nil)
+	result, err := r.client.GetByID(ctx, "Group", state.ID.ValueInt64(), groupSelect)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Group no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading group",
@@ -352,89 +343,16 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Update state
-	if name, ok := GetString(result, "name"); ok {
-		state.Name = types.StringValue(name)
-	}
-
-	if title, ok := GetString(result, "title"); ok {
-		state.Title = types.StringValue(title)
-	}
-
-	if desc, ok := GetString(result, "description"); ok && desc != "" {
-		state.Description = types.StringValue(desc)
-	} else {
-		state.Description = types.StringNull()
-	}
-
-	if active, ok := GetBool(result, "is_active"); ok {
-		state.IsActive = types.BoolValue(active)
-	}
-
-	if visibility, ok := GetString(result, "visibility"); ok {
-		state.Visibility = types.StringValue(visibility)
-	}
-
-	// Handle group_type from API response
-	if groupTypeRaw, ok := result["group_type"]; ok && groupTypeRaw != nil {
-		if groupTypeSlice, ok := groupTypeRaw.([]any); ok {
-			ids := make([]string, 0, len(groupTypeSlice))
-			for _, v := range groupTypeSlice {
-				if s, ok := v.(string); ok {
-					ids = append(ids, s)
-				}
-			}
-			names := convertGroupTypeIDsToNames(ids)
-			groupTypeList, diags := types.ListValueFrom(ctx, types.StringType, names)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				state.GroupType = groupTypeList
-			}
-		}
-	}
-
-	if hidden, ok := GetBool(result, "is_hidden"); ok {
-		state.IsHidden = types.BoolValue(hidden)
-	}
-
-	if reserved, ok := GetBool(result, "is_reserved"); ok {
-		state.IsReserved = types.BoolValue(reserved)
-	}
-
-	if frontendTitle, ok := GetString(result, "frontend_title"); ok && frontendTitle != "" {
-		state.FrontendTitle = types.StringValue(frontendTitle)
-	} else {
-		state.FrontendTitle = types.StringNull()
-	}
-
-	if frontendDesc, ok := GetString(result, "frontend_description"); ok && frontendDesc != "" {
-		state.FrontendDescription = types.StringValue(frontendDesc)
-	} else {
-		state.FrontendDescription = types.StringNull()
-	}
-
-	// Handle parents from API response
-	if parentsRaw, ok := result["parents"]; ok && parentsRaw != nil {
-		if parentsSlice, ok := parentsRaw.([]any); ok {
-			parentIDs := make([]int64, 0, len(parentsSlice))
-			for _, v := range parentsSlice {
-				if id, ok := v.(float64); ok {
-					parentIDs = append(parentIDs, int64(id))
-				} else if id, ok := v.(int64); ok {
-					parentIDs = append(parentIDs, id)
-				}
-			}
-			if len(parentIDs) > 0 {
-				parentsList, diags := types.ListValueFrom(ctx, types.Int64Type, parentIDs)
-				resp.Diagnostics.Append(diags...)
-				if !resp.Diagnostics.HasError() {
-					state.Parents = parentsList
-				}
-			}
-		}
-	}
+	var d diag.Diagnostics
+	r.mapResponseToModel(ctx, result, &state, &d)
+	resp.Diagnostics.Append(d...)
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+
+	// Keep the prior value of attributes managed outside of Terraform
+	ignored := ignoredFields(ctx, state.IgnoreFields, &resp.Diagnostics)
+	keepIgnoredFields(ctx, ignored, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -480,7 +398,15 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 			return
 		}
 		// Convert human-readable names to API IDs
-		values["group_type"] = convertGroupTypesToIDs(groupTypes)
+		groupTypeIDs, err := r.convertGroupTypesToIDs(ctx, groupTypes)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error resolving group types",
+				"Could not resolve group_type: "+err.Error(),
+			)
+			return
+		}
+		values["group_type"] = groupTypeIDs
 	}
 
 	if !plan.FrontendTitle.IsNull() {
@@ -507,8 +433,14 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		values["parents"] = nil
 	}
 
+	ignored := ignoredFields(ctx, plan.IgnoreFields, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	omitIgnoredFields(values, ignored, nil)
+
 	// Call API
-	result, err := r.client.Update("Group", state.ID.ValueInt64(), values)
+	result, err := r.client.Update(ctx, "Group", state.ID.ValueInt64(), values)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating group",
@@ -519,27 +451,82 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	// Update state
 	plan.ID = state.ID
+	var d diag.Diagnostics
+	r.mapResponseToModel(ctx, result, &plan, &d)
+	resp.Diagnostics.Append(d...)
+
+	tflog.Debug(ctx, "Updated group", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	keepIgnoredFields(ctx, ignored, req.Plan, &resp.State, &resp.Diagnostics)
+}
+
+func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state GroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting group", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	err := r.client.Delete(ctx, "Group", state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting group",
+			"Could not delete group ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted group", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+}
+
+func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Could not parse import ID as integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// mapResponseToModel is shared by Create, Read and Update so that the same
+// server response always produces the same state.
+func (r *GroupResource) mapResponseToModel(ctx context.Context, result map[string]any, model *GroupResourceModel, diags *diag.Diagnostics) {
+	if id, ok := GetInt64(result, "id"); ok {
+		model.ID = types.Int64Value(id)
+	}
 
 	if name, ok := GetString(result, "name"); ok {
-		plan.Name = types.StringValue(name)
+		model.Name = types.StringValue(name)
 	}
 
 	if title, ok := GetString(result, "title"); ok {
-		plan.Title = types.StringValue(title)
+		model.Title = types.StringValue(title)
 	}
 
-	if desc, ok := GetString(result, "description"); ok && desc != "" {
-		plan.Description = types.StringValue(desc)
-	} else {
-		plan.Description = types.StringNull()
-	}
+	model.Description = r.client.optionalString(result, "description", model.Description)
 
 	if active, ok := GetBool(result, "is_active"); ok {
-		plan.IsActive = types.BoolValue(active)
+		model.IsActive = types.BoolValue(active)
 	}
 
 	if visibility, ok := GetString(result, "visibility"); ok {
-		plan.Visibility = types.StringValue(visibility)
+		model.Visibility = types.StringValue(visibility)
 	}
 
 	// Handle group_type from API response
@@ -551,34 +538,33 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 					ids = append(ids, s)
 				}
 			}
-			names := convertGroupTypeIDsToNames(ids)
-			groupTypeList, diags := types.ListValueFrom(ctx, types.StringType, names)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				plan.GroupType = groupTypeList
+			names, err := r.convertGroupTypeIDsToNames(ctx, ids)
+			if err != nil {
+				diags.AddError(
+					"Error resolving group types",
+					"Could not resolve group_type: "+err.Error(),
+				)
+			} else {
+				groupTypeList, d := types.ListValueFrom(ctx, types.StringType, names)
+				diags.Append(d...)
+				if !d.HasError() {
+					model.GroupType = groupTypeList
+				}
 			}
 		}
 	}
 
 	if hidden, ok := GetBool(result, "is_hidden"); ok {
-		plan.IsHidden = types.BoolValue(hidden)
+		model.IsHidden = types.BoolValue(hidden)
 	}
 
 	if reserved, ok := GetBool(result, "is_reserved"); ok {
-		plan.IsReserved = types.BoolValue(reserved)
+		model.IsReserved = types.BoolValue(reserved)
 	}
 
-	if frontendTitle, ok := GetString(result, "frontend_title"); ok && frontendTitle != "" {
-		plan.FrontendTitle = types.StringValue(frontendTitle)
-	} else {
-		plan.FrontendTitle = types.StringNull()
-	}
+	model.FrontendTitle = r.client.optionalString(result, "frontend_title", model.FrontendTitle)
 
-	if frontendDesc, ok := GetString(result, "frontend_description"); ok && frontendDesc != "" {
-		plan.FrontendDescription = types.StringValue(frontendDesc)
-	} else {
-		plan.FrontendDescription = types.StringNull()
-	}
+	model.FrontendDescription = r.client.optionalString(result, "frontend_description", model.FrontendDescription)
 
 	// Handle parents from API response
 	if parentsRaw, ok := result["parents"]; ok && parentsRaw != nil {
@@ -592,58 +578,12 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 				}
 			}
 			if len(parentIDs) > 0 {
-				parentsList, diags := types.ListValueFrom(ctx, types.Int64Type, parentIDs)
-				resp.Diagnostics.Append(diags...)
-				if !resp.Diagnostics.HasError() {
-					plan.Parents = parentsList
+				parentsList, d := types.ListValueFrom(ctx, types.Int64Type, parentIDs)
+				diags.Append(d...)
+				if !d.HasError() {
+					model.Parents = parentsList
 				}
 			}
 		}
 	}
-
-	tflog.Debug(ctx, "Updated group", map[string]any{
-		"id": plan.ID.ValueInt64(),
-	})
-
-	diags = resp.State.Set(ctx, plan)
-	resp.Diagnostics.Append(diags...)
-}
-
-func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state GroupResourceModel
-	diags := req.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	tflog.Debug(ctx, "Deleting group", map[string]any{
-		"id": state.ID.ValueInt64(),
-	})
-
-	err := r.client.Delete("Group", state.ID.ValueInt64())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error deleting group",
-			"Could not delete group ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
-		)
-		return
-	}
-
-	tflog.Debug(ctx, "Deleted group", map[string]any{
-		"id": state.ID.ValueInt64(),
-	})
-}
-
-func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id, err := strconv.ParseInt(req.ID, 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid import ID",
-			"Could not parse import ID as integer: "+err.Error(),
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGroupResourceCreateAndReadProduceSameState(t *testing.T) {
+	tests := []struct {
+		name   string
+		record map[string]any
+	}{
+		{
+			name: "frontend fields set",
+			record: map[string]any{
+				"id":                   12,
+				"name":                 "newsletter",
+				"title":                "Newsletter",
+				"description":          "",
+				"frontend_title":       "Our Newsletter",
+				"frontend_description": "Monthly news",
+				"is_active":            true,
+				"visibility":           "Public Pages",
+				"group_type":           []any{"2"},
+				"is_hidden":            false,
+				"is_reserved":          false,
+				"parents":              []any{},
+			},
+		},
+		{
+			name: "frontend fields empty",
+			record: map[string]any{
+				"id":                   13,
+				"name":                 "volunteers",
+				"title":                "Volunteers",
+				"description":          nil,
+				"frontend_title":       "",
+				"frontend_description": nil,
+				"is_active":            true,
+				"visibility":           "User and User Admin Only",
+				"group_type":           []any{"1", "2"},
+				"is_hidden":            false,
+				"is_reserved":          false,
+				"parents":              []any{float64(4)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			client := newTestClient(t, func(req testRequest) ([]map[string]any, error) {
+				switch req.Entity + "." + req.Action {
+				case "Group.create", "Group.get":
+					return []map[string]any{tt.record}, nil
+				}
+				t.Errorf("unexpected request %s.%s", req.Entity, req.Action)
+				return nil, nil
+			})
+
+			r := &GroupResource{}
+			s := newTestResource(t, r, client)
+
+			plan := GroupResourceModel{
+				ID:                  types.Int64Unknown(),
+				Name:                types.StringValue(tt.record["name"].(string)),
+				Title:               types.StringValue(tt.record["title"].(string)),
+				Description:         types.StringNull(),
+				IsActive:            types.BoolValue(true),
+				Visibility:          types.StringValue(tt.record["visibility"].(string)),
+				GroupType:           types.ListNull(types.StringType),
+				IsHidden:            types.BoolValue(false),
+				IsReserved:          types.BoolValue(false),
+				FrontendTitle:       types.StringUnknown(),
+				FrontendDescription: types.StringUnknown(),
+				Parents:             types.ListNull(types.Int64Type),
+				IgnoreFields:        types.ListNull(types.StringType),
+			}
+
+			createResp := &resource.CreateResponse{State: emptyTestState(s)}
+			r.Create(ctx, resource.CreateRequest{Plan: testPlan(t, s, plan), Config: testConfig(t, s, plan)}, createResp)
+			if createResp.Diagnostics.HasError() {
+				t.Fatalf("Create: %v", createResp.Diagnostics)
+			}
+
+			readResp := &resource.ReadResponse{State: createResp.State}
+			r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+			if readResp.Diagnostics.HasError() {
+				t.Fatalf("Read: %v", readResp.Diagnostics)
+			}
+
+			if !createResp.State.Raw.Equal(readResp.State.Raw) {
+				t.Errorf("Create and Read produced different states:\ncreate: %s\nread:   %s", createResp.State.Raw, readResp.State.Raw)
+			}
+
+			var state GroupResourceModel
+			readResp.State.Get(ctx, &state)
+			for name, value := range map[string]attr.Value{
+				"frontend_title":       state.FrontendTitle,
+				"frontend_description": state.FrontendDescription,
+			} {
+				if value.IsUnknown() {
+					t.Errorf("%s is unknown after Read", name)
+				}
+				if want, _ := tt.record[name].(string); want == "" && !value.IsNull() {
+					t.Errorf("%s = %s, want null for an empty value", name, value)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &GroupTypeResource{}
+	_ resource.ResourceWithConfigure      = &GroupTypeResource{}
+	_ resource.ResourceWithImportState    = &GroupTypeResource{}
+	_ resource.ResourceWithValidateConfig = &GroupTypeResource{}
+)
+
+// GroupTypeResource manages group types in CiviCRM.
+// Group types are stored as OptionValues in the "group_type" option group.
+type GroupTypeResource struct {
+	optionValues optionValueCRUD
+}
+
+func NewGroupTypeResource() resource.Resource {
+	return &GroupTypeResource{
+		optionValues: optionValueCRUD{optionGroup: "group_type", noun: "group type"},
+	}
+}
+
+func (r *GroupTypeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_type"
+}
+
+func (r *GroupTypeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a CiviCRM Group Type. Group types can be assigned to groups via the group_type attribute of civicrm_group.",
+		Attributes:  r.optionValues.schemaAttributes(),
+	}
+}
+
+func (r *GroupTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.optionValues.client = client
+}
+
+// ValidateConfig rejects ignore_fields entries that name no attribute or one
+// that cannot be ignored.
+func (r *GroupTypeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateIgnoredFields(ctx, req.Config, &resp.Diagnostics)
+}
+
+func (r *GroupTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan optionValueModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating group type", map[string]any{
+		"name":  plan.Name.ValueString(),
+		"label": plan.Label.ValueString(),
+	})
+
+	weight := configuredOptionValueWeight(ctx, req.Config, &resp.Diagnostics)
+	if err := r.optionValues.create(ctx, &plan, weight, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating group type",
+			"Could not create group type, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Created group type", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *GroupTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state optionValueModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading group type", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	err := r.optionValues.read(ctx, &state, nil)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Group type no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading group type",
+			"Could not read group type ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+
+	// Keep the prior value of attributes managed outside of Terraform
+	ignored := ignoredFields(ctx, state.IgnoreFields, &resp.Diagnostics)
+	keepIgnoredFields(ctx, ignored, req.State, &resp.State, &resp.Diagnostics)
+}
+
+func (r *GroupTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan optionValueModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state optionValueModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating group type", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	weight := configuredOptionValueWeight(ctx, req.Config, &resp.Diagnostics)
+	ignored := ignoredFields(ctx, plan.IgnoreFields, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.optionValues.update(ctx, state.ID.ValueInt64(), &plan, weight, nil, ignored); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating group type",
+			"Could not update group type ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Updated group type", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	keepIgnoredFields(ctx, ignored, req.Plan, &resp.State, &resp.Diagnostics)
+}
+
+func (r *GroupTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state optionValueModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting group type", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	if err := r.optionValues.delete(ctx, state.ID.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting group type",
+			"Could not delete group type ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted group type", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+}
+
+func (r *GroupTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Could not parse import ID as integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
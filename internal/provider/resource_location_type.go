@@ -0,0 +1,335 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &LocationTypeResource{}
+	_ resource.ResourceWithConfigure   = &LocationTypeResource{}
+	_ resource.ResourceWithImportState = &LocationTypeResource{}
+	_ resource.ResourceWithModifyPlan  = &LocationTypeResource{}
+)
+
+// LocationTypeResource manages location types (Home, Work, Billing, ...) in
+// CiviCRM.
+type LocationTypeResource struct {
+	client *Client
+}
+
+type LocationTypeResourceModel struct {
+	ID                   types.Int64  `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	DisplayName          types.String `tfsdk:"display_name"`
+	VcardName            types.String `tfsdk:"vcard_name"`
+	Description          types.String `tfsdk:"description"`
+	IsDefault            types.Bool   `tfsdk:"is_default"`
+	IsReserved           types.Bool   `tfsdk:"is_reserved"`
+	IsActive             types.Bool   `tfsdk:"is_active"`
+	AllowReservedChanges types.Bool   `tfsdk:"allow_reserved_changes"`
+}
+
+// locationTypeSelect selects the LocationType fields that mapResponseToModel
+// reads.
+var locationTypeSelect = []string{
+	"id", "name", "display_name", "vcard_name", "description", "is_active", "is_default",
+	"is_reserved",
+}
+
+func NewLocationTypeResource() resource.Resource {
+	return &LocationTypeResource{}
+}
+
+func (r *LocationTypeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_location_type"
+}
+
+func (r *LocationTypeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a CiviCRM location type, which classifies the addresses, email addresses and phone numbers of contacts (e.g., Home, Work).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier of the location type.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The machine name of the location type (must be unique).",
+				Required:    true,
+			},
+			"display_name": schema.StringAttribute{
+				Description: "The display label of the location type.",
+				Required:    true,
+			},
+			"vcard_name": schema.StringAttribute{
+				Description: "The vCard location type the location type is exported as (e.g., 'HOME', 'WORK').",
+				Optional:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the location type.",
+				Optional:    true,
+			},
+			"is_default": schema.BoolAttribute{
+				Description: "Whether this is the default location type for new locations. Default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"is_reserved": schema.BoolAttribute{
+				Description: "Whether this is a reserved system location type. Default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"is_active": schema.BoolAttribute{
+				Description: "Whether the location type is active. Default: true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"allow_reserved_changes": allowReservedChangesAttribute,
+		},
+	}
+}
+
+func (r *LocationTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan protects reserved location types from accidental changes.
+func (r *LocationTypeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkReservedChange(ctx, req, resp, "location type")
+}
+
+func (r *LocationTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan LocationTypeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating location type", map[string]any{
+		"name": plan.Name.ValueString(),
+	})
+
+	// Call API
+	result, err := r.client.Create(ctx, "LocationType", r.buildValues(plan, false))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating location type",
+			"Could not create location type, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update state with response
+	r.mapResponseToModel(result, &plan)
+
+	tflog.Debug(ctx, "Created location type", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *LocationTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state LocationTypeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading location type", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	result, err := r.client.GetByID(ctx, "LocationType", state.ID.ValueInt64(), locationTypeSelect)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Location type no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading location type",
+			"Could not read location type ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	r.mapResponseToModel(result, &state)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *LocationTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan LocationTypeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state LocationTypeResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating location type", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	// Call API
+	result, err := r.client.Update(ctx, "LocationType", state.ID.ValueInt64(), r.buildValues(plan, true))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating location type",
+			"Could not update location type ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	plan.ID = state.ID
+	r.mapResponseToModel(result, &plan)
+
+	tflog.Debug(ctx, "Updated location type", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *LocationTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state LocationTypeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting location type", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	err := r.client.Delete(ctx, "LocationType", state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting location type",
+			"Could not delete location type ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted location type", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+}
+
+func (r *LocationTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Could not parse import ID as integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("allow_reserved_changes"), false)...)
+}
+
+// buildValues builds the API values for plan. On update, null optionals are
+// sent as nil so that they are cleared.
+func (r *LocationTypeResource) buildValues(plan LocationTypeResourceModel, update bool) map[string]any {
+	values := map[string]any{
+		"name":         plan.Name.ValueString(),
+		"display_name": plan.DisplayName.ValueString(),
+		"is_default":   plan.IsDefault.ValueBool(),
+		"is_reserved":  plan.IsReserved.ValueBool(),
+		"is_active":    plan.IsActive.ValueBool(),
+	}
+
+	for _, field := range []struct {
+		key   string
+		value types.String
+	}{
+		{"vcard_name", plan.VcardName},
+		{"description", plan.Description},
+	} {
+		if !field.value.IsNull() {
+			values[field.key] = field.value.ValueString()
+		} else if update {
+			values[field.key] = nil
+		}
+	}
+
+	return values
+}
+
+func (r *LocationTypeResource) mapResponseToModel(result map[string]any, model *LocationTypeResourceModel) {
+	if id, ok := GetInt64(result, "id"); ok {
+		model.ID = types.Int64Value(id)
+	}
+
+	if name, ok := GetString(result, "name"); ok {
+		model.Name = types.StringValue(name)
+	}
+
+	if displayName, ok := GetString(result, "display_name"); ok {
+		model.DisplayName = types.StringValue(displayName)
+	}
+
+	model.VcardName = r.client.optionalString(result, "vcard_name", model.VcardName)
+	model.Description = r.client.optionalString(result, "description", model.Description)
+
+	if isDefault, ok := GetBool(result, "is_default"); ok {
+		model.IsDefault = types.BoolValue(isDefault)
+	}
+
+	if isReserved, ok := GetBool(result, "is_reserved"); ok {
+		model.IsReserved = types.BoolValue(isReserved)
+	}
+
+	if isActive, ok := GetBool(result, "is_active"); ok {
+		model.IsActive = types.BoolValue(isActive)
+	}
+}
@@ -2,23 +2,27 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource                = &MailSettingsResource{}
-	_ resource.ResourceWithConfigure   = &MailSettingsResource{}
-	_ resource.ResourceWithImportState = &MailSettingsResource{}
+	_ resource.Resource                   = &MailSettingsResource{}
+	_ resource.ResourceWithConfigure      = &MailSettingsResource{}
+	_ resource.ResourceWithImportState    = &MailSettingsResource{}
+	_ resource.ResourceWithValidateConfig = &MailSettingsResource{}
 )
 
 // MailSettingsResource manages mail settings in CiviCRM.
@@ -52,6 +56,16 @@ type MailSettingsResourceModel struct {
 	ActivityAssignees                  types.String `tfsdk:"activity_assignees"`
 }
 
+// mailSettingsSelect selects the MailSettings fields that mapResponseToModel
+// reads. The password is write-only and not read back.
+var mailSettingsSelect = []string{
+	"id", "domain_id", "name", "is_default", "domain", "localpart", "return_path",
+	"protocol", "server", "port", "username", "is_ssl", "source", "activity_status",
+	"is_non_case_email_skipped", "is_contact_creation_disabled_if_no_match",
+	"activity_type_id", "campaign_id", "activity_source", "activity_targets",
+	"activity_assignees", "is_active",
+}
+
 func NewMailSettingsResource() resource.Resource {
 	return &MailSettingsResource{}
 }
@@ -99,8 +113,11 @@ func (r *MailSettingsResource) Schema(ctx context.Context, req resource.SchemaRe
 				Optional:    true,
 			},
 			"protocol": schema.StringAttribute{
-				Description: "The mail protocol (e.g., 'IMAP', 'POP3', 'Maildir', 'Localdir').",
+				Description: "The mail protocol. Options: 'IMAP', 'POP3', 'Maildir', 'Localdir'.",
 				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("IMAP", "POP3", "Maildir", "Localdir"),
+				},
 			},
 			"server": schema.StringAttribute{
 				Description: "The mail server hostname.",
@@ -126,7 +143,7 @@ func (r *MailSettingsResource) Schema(ctx context.Context, req resource.SchemaRe
 				Default:     booldefault.StaticBool(false),
 			},
 			"source": schema.StringAttribute{
-				Description: "The mail source (folder path for Maildir/Localdir).",
+				Description: "The mail source: the folder to read for IMAP, or the directory path for Maildir/Localdir (required for those).",
 				Optional:    true,
 			},
 			"activity_status": schema.StringAttribute{
@@ -175,6 +192,57 @@ func (r *MailSettingsResource) Schema(ctx context.Context, req resource.SchemaRe
 	}
 }
 
+// ValidateConfig checks that the connection attributes fit the protocol.
+// Maildir and Localdir read from a local directory given as source, while IMAP
+// and POP3 connect to a server.
+func (r *MailSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config MailSettingsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Protocol.IsNull() || config.Protocol.IsUnknown() {
+		return
+	}
+
+	protocol := config.Protocol.ValueString()
+	switch protocol {
+	case "Maildir", "Localdir":
+		if !config.Source.IsUnknown() && config.Source.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("source"),
+				"Missing mail source",
+				"The "+protocol+" protocol reads mail from a local directory, which must be set as source.",
+			)
+		}
+
+		if config.IsSSL.ValueBool() {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("is_ssl"),
+				"SSL is not used",
+				"is_ssl only applies to the IMAP and POP3 protocols and is ignored for "+protocol+".",
+			)
+		}
+	case "IMAP", "POP3":
+		if !config.Server.IsUnknown() && config.Server.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("server"),
+				"Missing mail server",
+				"The "+protocol+" protocol requires a server.",
+			)
+		}
+
+		if protocol == "POP3" && !config.Source.IsNull() && !config.Source.IsUnknown() {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("source"),
+				"Mail source is not used",
+				"POP3 has no folders, so source is ignored.",
+			)
+		}
+	}
+}
+
 func (r *MailSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -279,7 +347,7 @@ func (r *MailSettingsResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	// Call API
-	result, err := r.client.Create("MailSettings", values)
+	result, err := r.client.Create(ctx, "MailSettings", values)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating mail settings",
@@ -311,7 +379,14 @@ func (r *MailSettingsResource) Read(ctx context.Context, req resource.ReadReques
 		"id": state.ID.ValueInt64(),
 	})
 
-	result, err := r.client.GetByID("MailSettings", state.ID.ValueInt64(), nil)
+	result, err := r.client.GetByID(ctx, "MailSettings", state.ID.ValueInt64(), mailSettingsSelect)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Mail settings no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading mail settings",
@@ -451,7 +526,7 @@ func (r *MailSettingsResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	// Call API
-	result, err := r.client.Update("MailSettings", state.ID.ValueInt64(), values)
+	result, err := r.client.Update(ctx, "MailSettings", state.ID.ValueInt64(), values)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating mail settings",
@@ -484,7 +559,7 @@ func (r *MailSettingsResource) Delete(ctx context.Context, req resource.DeleteRe
 		"id": state.ID.ValueInt64(),
 	})
 
-	err := r.client.Delete("MailSettings", state.ID.ValueInt64())
+	err := r.client.Delete(ctx, "MailSettings", state.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting mail settings",
@@ -529,35 +604,15 @@ func (r *MailSettingsResource) mapResponseToModel(result map[string]any, model *
 		model.IsDefault = types.BoolValue(isDefault)
 	}
 
-	if domain, ok := GetString(result, "domain"); ok && domain != "" {
-		model.Domain = types.StringValue(domain)
-	} else {
-		model.Domain = types.StringNull()
-	}
+	model.Domain = r.client.optionalString(result, "domain", model.Domain)
 
-	if localpart, ok := GetString(result, "localpart"); ok && localpart != "" {
-		model.Localpart = types.StringValue(localpart)
-	} else {
-		model.Localpart = types.StringNull()
-	}
+	model.Localpart = r.client.optionalString(result, "localpart", model.Localpart)
 
-	if returnPath, ok := GetString(result, "return_path"); ok && returnPath != "" {
-		model.ReturnPath = types.StringValue(returnPath)
-	} else {
-		model.ReturnPath = types.StringNull()
-	}
+	model.ReturnPath = r.client.optionalString(result, "return_path", model.ReturnPath)
 
-	if protocol, ok := GetString(result, "protocol"); ok && protocol != "" {
-		model.Protocol = types.StringValue(protocol)
-	} else {
-		model.Protocol = types.StringNull()
-	}
+	model.Protocol = r.client.optionalString(result, "protocol", model.Protocol)
 
-	if server, ok := GetString(result, "server"); ok && server != "" {
-		model.Server = types.StringValue(server)
-	} else {
-		model.Server = types.StringNull()
-	}
+	model.Server = r.client.optionalString(result, "server", model.Server)
 
 	if port, ok := GetInt64(result, "port"); ok {
 		model.Port = types.Int64Value(port)
@@ -565,11 +620,7 @@ func (r *MailSettingsResource) mapResponseToModel(result map[string]any, model *
 		model.Port = types.Int64Null()
 	}
 
-	if username, ok := GetString(result, "username"); ok && username != "" {
-		model.Username = types.StringValue(username)
-	} else {
-		model.Username = types.StringNull()
-	}
+	model.Username = r.client.optionalString(result, "username", model.Username)
 
 	// Don't read password back from API for security reasons
 	// Keep the planned value
@@ -578,17 +629,9 @@ func (r *MailSettingsResource) mapResponseToModel(result map[string]any, model *
 		model.IsSSL = types.BoolValue(isSSL)
 	}
 
-	if source, ok := GetString(result, "source"); ok && source != "" {
-		model.Source = types.StringValue(source)
-	} else {
-		model.Source = types.StringNull()
-	}
+	model.Source = r.client.optionalString(result, "source", model.Source)
 
-	if activityStatus, ok := GetString(result, "activity_status"); ok && activityStatus != "" {
-		model.ActivityStatus = types.StringValue(activityStatus)
-	} else {
-		model.ActivityStatus = types.StringNull()
-	}
+	model.ActivityStatus = r.client.optionalString(result, "activity_status", model.ActivityStatus)
 
 	if isNonCaseEmailSkipped, ok := GetBool(result, "is_non_case_email_skipped"); ok {
 		model.IsNonCaseEmailSkipped = types.BoolValue(isNonCaseEmailSkipped)
@@ -614,21 +657,9 @@ func (r *MailSettingsResource) mapResponseToModel(result map[string]any, model *
 		model.CampaignID = types.Int64Null()
 	}
 
-	if activitySource, ok := GetString(result, "activity_source"); ok && activitySource != "" {
-		model.ActivitySource = types.StringValue(activitySource)
-	} else {
-		model.ActivitySource = types.StringNull()
-	}
+	model.ActivitySource = r.client.optionalString(result, "activity_source", model.ActivitySource)
 
-	if activityTargets, ok := GetString(result, "activity_targets"); ok && activityTargets != "" {
-		model.ActivityTargets = types.StringValue(activityTargets)
-	} else {
-		model.ActivityTargets = types.StringNull()
-	}
+	model.ActivityTargets = r.client.optionalString(result, "activity_targets", model.ActivityTargets)
 
-	if activityAssignees, ok := GetString(result, "activity_assignees"); ok && activityAssignees != "" {
-		model.ActivityAssignees = types.StringValue(activityAssignees)
-	} else {
-		model.ActivityAssignees = types.StringNull()
-	}
+	model.ActivityAssignees = r.client.optionalString(result, "activity_assignees", model.ActivityAssignees)
 }
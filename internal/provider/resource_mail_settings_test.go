@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMailSettingsResourceValidateConfig(t *testing.T) {
+	type attributeDiagnostic struct {
+		severity  diag.Severity
+		attribute string
+	}
+
+	tests := []struct {
+		name     string
+		protocol types.String
+		server   types.String
+		source   types.String
+		isSSL    types.Bool
+		want     []attributeDiagnostic
+	}{
+		{
+			name:     "IMAP with folder",
+			protocol: types.StringValue("IMAP"),
+			server:   types.StringValue("imap.example.org"),
+			source:   types.StringValue("INBOX"),
+			isSSL:    types.BoolValue(true),
+		},
+		{
+			name:     "IMAP without server",
+			protocol: types.StringValue("IMAP"),
+			server:   types.StringNull(),
+			source:   types.StringValue("INBOX"),
+			isSSL:    types.BoolNull(),
+			want:     []attributeDiagnostic{{diag.SeverityError, "server"}},
+		},
+		{
+			name:     "IMAP with unknown server",
+			protocol: types.StringValue("IMAP"),
+			server:   types.StringUnknown(),
+			source:   types.StringNull(),
+			isSSL:    types.BoolNull(),
+		},
+		{
+			name:     "POP3",
+			protocol: types.StringValue("POP3"),
+			server:   types.StringValue("pop.example.org"),
+			source:   types.StringNull(),
+			isSSL:    types.BoolValue(true),
+		},
+		{
+			name:     "POP3 with folder",
+			protocol: types.StringValue("POP3"),
+			server:   types.StringValue("pop.example.org"),
+			source:   types.StringValue("INBOX"),
+			isSSL:    types.BoolNull(),
+			want:     []attributeDiagnostic{{diag.SeverityWarning, "source"}},
+		},
+		{
+			name:     "POP3 without server",
+			protocol: types.StringValue("POP3"),
+			server:   types.StringValue(""),
+			source:   types.StringNull(),
+			isSSL:    types.BoolNull(),
+			want:     []attributeDiagnostic{{diag.SeverityError, "server"}},
+		},
+		{
+			name:     "Maildir with path",
+			protocol: types.StringValue("Maildir"),
+			server:   types.StringNull(),
+			source:   types.StringValue("/var/mail/civicrm"),
+			isSSL:    types.BoolNull(),
+		},
+		{
+			name:     "Maildir without path",
+			protocol: types.StringValue("Maildir"),
+			server:   types.StringNull(),
+			source:   types.StringNull(),
+			isSSL:    types.BoolNull(),
+			want:     []attributeDiagnostic{{diag.SeverityError, "source"}},
+		},
+		{
+			name:     "Localdir with empty path and SSL",
+			protocol: types.StringValue("Localdir"),
+			server:   types.StringNull(),
+			source:   types.StringValue(""),
+			isSSL:    types.BoolValue(true),
+			want: []attributeDiagnostic{
+				{diag.SeverityError, "source"},
+				{diag.SeverityWarning, "is_ssl"},
+			},
+		},
+		{
+			name:     "Localdir with unknown path",
+			protocol: types.StringValue("Localdir"),
+			server:   types.StringNull(),
+			source:   types.StringUnknown(),
+			isSSL:    types.BoolValue(false),
+		},
+		{
+			name:     "unknown protocol",
+			protocol: types.StringUnknown(),
+			server:   types.StringNull(),
+			source:   types.StringNull(),
+			isSSL:    types.BoolValue(true),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &MailSettingsResource{}
+			s := newTestResource(t, r, nil)
+
+			config := MailSettingsResourceModel{
+				ID:                                 types.Int64Null(),
+				DomainID:                           types.Int64Null(),
+				Name:                               types.StringValue("Bounces"),
+				IsDefault:                          types.BoolNull(),
+				Domain:                             types.StringValue("example.org"),
+				Localpart:                          types.StringNull(),
+				ReturnPath:                         types.StringNull(),
+				Protocol:                           tt.protocol,
+				Server:                             tt.server,
+				Port:                               types.Int64Null(),
+				Username:                           types.StringNull(),
+				Password:                           types.StringNull(),
+				IsSSL:                              tt.isSSL,
+				Source:                             tt.source,
+				ActivityStatus:                     types.StringNull(),
+				IsNonCaseEmailSkipped:              types.BoolNull(),
+				IsContactCreationDisabledIfNoMatch: types.BoolNull(),
+				IsActive:                           types.BoolNull(),
+				ActivityTypeID:                     types.Int64Null(),
+				CampaignID:                         types.Int64Null(),
+				ActivitySource:                     types.StringNull(),
+				ActivityTargets:                    types.StringNull(),
+				ActivityAssignees:                  types.StringNull(),
+			}
+
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: testConfig(t, s, config)}, resp)
+
+			if len(resp.Diagnostics) != len(tt.want) {
+				t.Fatalf("got %d diagnostics, want %d: %v", len(resp.Diagnostics), len(tt.want), resp.Diagnostics)
+			}
+			for _, want := range tt.want {
+				if !hasAttributeDiagnostic(resp.Diagnostics, want.severity, want.attribute) {
+					t.Errorf("missing %s for %s: %v", want.severity, want.attribute, resp.Diagnostics)
+				}
+			}
+		})
+	}
+}
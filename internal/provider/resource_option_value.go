@@ -0,0 +1,449 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &OptionValueResource{}
+	_ resource.ResourceWithConfigure   = &OptionValueResource{}
+	_ resource.ResourceWithImportState = &OptionValueResource{}
+)
+
+// OptionValueResource manages a value of any CiviCRM option group, such as
+// activity types, genders or individual prefixes. Option groups with their own
+// resource, like group types and ACL roles, are better managed with that.
+type OptionValueResource struct {
+	client *Client
+}
+
+type OptionValueResourceModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	OptionGroupID   types.Int64  `tfsdk:"option_group_id"`
+	OptionGroupName types.String `tfsdk:"option_group_name"`
+	Name            types.String `tfsdk:"name"`
+	Label           types.String `tfsdk:"label"`
+	Value           types.String `tfsdk:"value"`
+	Description     types.String `tfsdk:"description"`
+	Weight          types.Int64  `tfsdk:"weight"`
+	Filter          types.Int64  `tfsdk:"filter"`
+	Color           types.String `tfsdk:"color"`
+	Icon            types.String `tfsdk:"icon"`
+	IsDefault       types.Bool   `tfsdk:"is_default"`
+	IsActive        types.Bool   `tfsdk:"is_active"`
+}
+
+func NewOptionValueResource() resource.Resource {
+	return &OptionValueResource{}
+}
+
+func (r *OptionValueResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_option_value"
+}
+
+func (r *OptionValueResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a value of a CiviCRM option group, such as an activity type, gender or individual prefix.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier of the option value.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"option_group_id": schema.Int64Attribute{
+				Description: "The ID of the option group. Exactly one of option_group_id and option_group_name must be set. Changing this forces a new option value.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("option_group_name")),
+				},
+			},
+			"option_group_name": schema.StringAttribute{
+				Description: "The name of the option group (e.g., 'activity_type', 'gender', 'individual_prefix'). Exactly one of option_group_id and option_group_name must be set. Changing this forces a new option value.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The machine name of the option value. CiviCRM derives it from the label when not set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description: "The display label of the option value.",
+				Required:    true,
+			},
+			"value": schema.StringAttribute{
+				Description: "The value CiviCRM stores on referencing records. CiviCRM assigns the next free number when not set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the option value.",
+				Optional:    true,
+			},
+			"weight": managedWeightAttribute("option value"),
+			"filter": optionValueFilterAttribute("option value"),
+			"color": schema.StringAttribute{
+				Description: "The color of the option value as a hex code (e.g., '#2786c2'), used by some option groups such as activity statuses.",
+				Optional:    true,
+			},
+			"icon": schema.StringAttribute{
+				Description: "The icon of the option value (CSS class name, e.g., 'fa-phone').",
+				Optional:    true,
+			},
+			"is_default": schema.BoolAttribute{
+				Description: "Whether this is the default value of the option group. Default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"is_active": schema.BoolAttribute{
+				Description: "Whether the option value is active. Default: true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *OptionValueResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OptionValueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan OptionValueResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating option value", map[string]any{
+		"option_group_name": plan.OptionGroupName.ValueString(),
+		"label":             plan.Label.ValueString(),
+	})
+
+	optionGroupID := plan.OptionGroupID.ValueInt64()
+	if plan.OptionGroupID.IsNull() || plan.OptionGroupID.IsUnknown() {
+		id, err := r.client.GetOptionGroupID(ctx, plan.OptionGroupName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("option_group_name"),
+				"Error looking up option group",
+				err.Error(),
+			)
+			return
+		}
+		optionGroupID = id
+	}
+
+	// Build values for API call
+	values := r.buildValues(plan, false)
+	values["option_group_id"] = optionGroupID
+
+	if weight, ok := configuredWeight(ctx, req.Config, &resp.Diagnostics); ok {
+		values["weight"] = weight
+	}
+
+	// Call API
+	result, err := r.client.Create(ctx, "OptionValue", values)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating option value",
+			"Could not create option value, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// The create response does not include the option group name
+	id, _ := GetInt64(result, "id")
+	result, err = r.client.GetByID(ctx, "OptionValue", id, optionValueSelect)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating option value",
+			"Could not read created option value ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state with response
+	r.mapResponseToModel(result, &plan)
+
+	tflog.Debug(ctx, "Created option value", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *OptionValueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state OptionValueResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading option value", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	result, err := r.client.GetByID(ctx, "OptionValue", state.ID.ValueInt64(), optionValueSelect)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Option value no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading option value",
+			"Could not read option value ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	r.mapResponseToModel(result, &state)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *OptionValueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan OptionValueResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state OptionValueResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating option value", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	// Build values for API call
+	values := r.buildValues(plan, true)
+
+	if weight, ok := configuredWeight(ctx, req.Config, &resp.Diagnostics); ok {
+		values["weight"] = weight
+	}
+
+	// Call API
+	if _, err := r.client.Update(ctx, "OptionValue", state.ID.ValueInt64(), values); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating option value",
+			"Could not update option value ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	result, err := r.client.GetByID(ctx, "OptionValue", state.ID.ValueInt64(), optionValueSelect)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating option value",
+			"Could not read option value ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	plan.ID = state.ID
+	r.mapResponseToModel(result, &plan)
+
+	tflog.Debug(ctx, "Updated option value", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *OptionValueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state OptionValueResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting option value", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	err := r.client.Delete(ctx, "OptionValue", state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting option value",
+			"Could not delete option value ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted option value", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+}
+
+func (r *OptionValueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Could not parse import ID as integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// optionValueSelect selects all OptionValue fields and the name of the option
+// group.
+var optionValueSelect = []string{"*", "option_group_id:name"}
+
+// buildValues builds the API values for plan, except the option group and the
+// weight. On update, null optional values are sent so that they are cleared.
+func (r *OptionValueResource) buildValues(plan OptionValueResourceModel, update bool) map[string]any {
+	values := map[string]any{
+		"label":      plan.Label.ValueString(),
+		"is_default": plan.IsDefault.ValueBool(),
+		"is_active":  plan.IsActive.ValueBool(),
+	}
+
+	if !plan.Name.IsNull() && !plan.Name.IsUnknown() {
+		values["name"] = plan.Name.ValueString()
+	}
+
+	if !plan.Value.IsNull() && !plan.Value.IsUnknown() {
+		values["value"] = plan.Value.ValueString()
+	}
+
+	optional := []struct {
+		key   string
+		value types.String
+	}{
+		{"description", plan.Description},
+		{"color", plan.Color},
+		{"icon", plan.Icon},
+	}
+	for _, o := range optional {
+		if !o.value.IsNull() {
+			values[o.key] = o.value.ValueString()
+		} else if update {
+			values[o.key] = nil
+		}
+	}
+
+	setOptionValueFilter(values, plan.Filter, update)
+
+	return values
+}
+
+func (r *OptionValueResource) mapResponseToModel(result map[string]any, model *OptionValueResourceModel) {
+	if id, ok := GetInt64(result, "id"); ok {
+		model.ID = types.Int64Value(id)
+	}
+
+	if optionGroupID, ok := GetInt64(result, "option_group_id"); ok {
+		model.OptionGroupID = types.Int64Value(optionGroupID)
+	}
+
+	if optionGroupName, ok := GetString(result, "option_group_id:name"); ok {
+		model.OptionGroupName = types.StringValue(optionGroupName)
+	} else if model.OptionGroupName.IsUnknown() {
+		model.OptionGroupName = types.StringNull()
+	}
+
+	if name, ok := GetString(result, "name"); ok {
+		model.Name = types.StringValue(name)
+	} else if model.Name.IsUnknown() {
+		model.Name = types.StringNull()
+	}
+
+	if label, ok := GetString(result, "label"); ok {
+		model.Label = types.StringValue(label)
+	}
+
+	if value, ok := GetString(result, "value"); ok {
+		model.Value = types.StringValue(value)
+	} else if model.Value.IsUnknown() {
+		model.Value = types.StringNull()
+	}
+
+	model.Description = r.client.optionalString(result, "description", model.Description)
+
+	if weight, ok := GetInt64(result, "weight"); ok {
+		model.Weight = types.Int64Value(weight)
+	} else if model.Weight.IsUnknown() {
+		model.Weight = types.Int64Null()
+	}
+
+	model.Filter = optionValueFilter(result)
+
+	model.Color = r.client.optionalString(result, "color", model.Color)
+
+	model.Icon = r.client.optionalString(result, "icon", model.Icon)
+
+	if isDefault, ok := GetBool(result, "is_default"); ok {
+		model.IsDefault = types.BoolValue(isDefault)
+	}
+
+	if isActive, ok := GetBool(result, "is_active"); ok {
+		model.IsActive = types.BoolValue(isActive)
+	}
+}
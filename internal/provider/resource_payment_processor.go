@@ -0,0 +1,434 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &PaymentProcessorResource{}
+	_ resource.ResourceWithConfigure   = &PaymentProcessorResource{}
+	_ resource.ResourceWithImportState = &PaymentProcessorResource{}
+)
+
+// PaymentProcessorResource manages payment processors in CiviCRM. CiviCRM
+// keeps a live and a test row per processor; each row is one resource.
+type PaymentProcessorResource struct {
+	client *Client
+}
+
+type PaymentProcessorResourceModel struct {
+	ID                     types.Int64  `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	Description            types.String `tfsdk:"description"`
+	PaymentProcessorTypeID types.Int64  `tfsdk:"payment_processor_type_id"`
+	IsTest                 types.Bool   `tfsdk:"is_test"`
+	IsActive               types.Bool   `tfsdk:"is_active"`
+	IsDefault              types.Bool   `tfsdk:"is_default"`
+	ClassName              types.String `tfsdk:"class_name"`
+	UserName               types.String `tfsdk:"user_name"`
+	Password               types.String `tfsdk:"password"`
+	Signature              types.String `tfsdk:"signature"`
+	Subject                types.String `tfsdk:"subject"`
+	URLSite                types.String `tfsdk:"url_site"`
+	URLAPI                 types.String `tfsdk:"url_api"`
+	URLRecur               types.String `tfsdk:"url_recur"`
+	DomainID               types.Int64  `tfsdk:"domain_id"`
+}
+
+// paymentProcessorSelect selects the PaymentProcessor fields that
+// mapResponseToModel reads.
+var paymentProcessorSelect = []string{
+	"id", "domain_id", "name", "description", "payment_processor_type_id", "is_active",
+	"is_default", "is_test", "user_name", "password", "signature", "subject", "url_site",
+	"url_api", "url_recur", "class_name",
+}
+
+func NewPaymentProcessorResource() resource.Resource {
+	return &PaymentProcessorResource{}
+}
+
+func (r *PaymentProcessorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_payment_processor"
+}
+
+func (r *PaymentProcessorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a CiviCRM Payment Processor. CiviCRM keeps a live and a test configuration per processor; manage each with its own resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier of the payment processor.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the payment processor. The live and test configuration of a processor share the name.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the payment processor.",
+				Optional:    true,
+			},
+			"payment_processor_type_id": schema.Int64Attribute{
+				Description: "The ID of the payment processor type (e.g., PayPal, Stripe). Changing this forces a new payment processor.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"is_test": schema.BoolAttribute{
+				Description: "Whether this is the test configuration of the processor. Changing this forces a new payment processor. Default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_active": schema.BoolAttribute{
+				Description: "Whether the payment processor is active. Default: true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"is_default": schema.BoolAttribute{
+				Description: "Whether this is the default payment processor. Default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"class_name": schema.StringAttribute{
+				Description: "The PHP class implementing the processor. CiviCRM takes it from the payment processor type when not set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_name": schema.StringAttribute{
+				Description: "The user name, API login or public key for the processor.",
+				Optional:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "The password or secret key for the processor.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"signature": schema.StringAttribute{
+				Description: "The signature or webhook secret for the processor.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"subject": schema.StringAttribute{
+				Description: "The subject, merchant or account ID for the processor.",
+				Optional:    true,
+			},
+			"url_site": schema.StringAttribute{
+				Description: "The URL of the processor site.",
+				Optional:    true,
+			},
+			"url_api": schema.StringAttribute{
+				Description: "The URL of the processor API.",
+				Optional:    true,
+			},
+			"url_recur": schema.StringAttribute{
+				Description: "The URL for recurring payments.",
+				Optional:    true,
+			},
+			"domain_id": schema.Int64Attribute{
+				Description: "The domain ID the payment processor belongs to.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PaymentProcessorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PaymentProcessorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PaymentProcessorResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating payment processor", map[string]any{
+		"name":    plan.Name.ValueString(),
+		"is_test": plan.IsTest.ValueBool(),
+	})
+
+	// Build values for API call
+	values := r.buildValues(plan, false)
+	values["payment_processor_type_id"] = plan.PaymentProcessorTypeID.ValueInt64()
+	values["is_test"] = plan.IsTest.ValueBool()
+
+	// Call API
+	result, err := r.client.Create(ctx, "PaymentProcessor", values)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating payment processor",
+			"Could not create payment processor, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update state with response
+	r.mapResponseToModel(result, &plan)
+
+	tflog.Debug(ctx, "Created payment processor", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *PaymentProcessorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PaymentProcessorResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading payment processor", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	result, err := r.client.GetByID(ctx, "PaymentProcessor", state.ID.ValueInt64(), paymentProcessorSelect)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Payment processor no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading payment processor",
+			"Could not read payment processor ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	r.mapResponseToModel(result, &state)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *PaymentProcessorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan PaymentProcessorResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state PaymentProcessorResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating payment processor", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	// Call API
+	result, err := r.client.Update(ctx, "PaymentProcessor", state.ID.ValueInt64(), r.buildValues(plan, true))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating payment processor",
+			"Could not update payment processor ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	plan.ID = state.ID
+	r.mapResponseToModel(result, &plan)
+
+	tflog.Debug(ctx, "Updated payment processor", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *PaymentProcessorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PaymentProcessorResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting payment processor", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	err := r.client.Delete(ctx, "PaymentProcessor", state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting payment processor",
+			"Could not delete payment processor ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted payment processor", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+}
+
+func (r *PaymentProcessorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Could not parse import ID as integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// buildValues builds the API values for plan that can change in place. On
+// update, null optional values are sent so that they are cleared.
+func (r *PaymentProcessorResource) buildValues(plan PaymentProcessorResourceModel, update bool) map[string]any {
+	values := map[string]any{
+		"name":       plan.Name.ValueString(),
+		"is_active":  plan.IsActive.ValueBool(),
+		"is_default": plan.IsDefault.ValueBool(),
+	}
+
+	if !plan.ClassName.IsNull() && !plan.ClassName.IsUnknown() {
+		values["class_name"] = plan.ClassName.ValueString()
+	}
+
+	if !plan.DomainID.IsNull() && !plan.DomainID.IsUnknown() {
+		values["domain_id"] = plan.DomainID.ValueInt64()
+	}
+
+	optional := []struct {
+		key   string
+		value types.String
+	}{
+		{"description", plan.Description},
+		{"user_name", plan.UserName},
+		{"password", plan.Password},
+		{"signature", plan.Signature},
+		{"subject", plan.Subject},
+		{"url_site", plan.URLSite},
+		{"url_api", plan.URLAPI},
+		{"url_recur", plan.URLRecur},
+	}
+	for _, o := range optional {
+		if !o.value.IsNull() {
+			values[o.key] = o.value.ValueString()
+		} else if update {
+			values[o.key] = nil
+		}
+	}
+
+	return values
+}
+
+func (r *PaymentProcessorResource) mapResponseToModel(result map[string]any, model *PaymentProcessorResourceModel) {
+	if id, ok := GetInt64(result, "id"); ok {
+		model.ID = types.Int64Value(id)
+	}
+
+	if name, ok := GetString(result, "name"); ok {
+		model.Name = types.StringValue(name)
+	}
+
+	model.Description = r.client.optionalString(result, "description", model.Description)
+
+	if typeID, ok := GetInt64(result, "payment_processor_type_id"); ok {
+		model.PaymentProcessorTypeID = types.Int64Value(typeID)
+	}
+
+	if isTest, ok := GetBool(result, "is_test"); ok {
+		model.IsTest = types.BoolValue(isTest)
+	}
+
+	if isActive, ok := GetBool(result, "is_active"); ok {
+		model.IsActive = types.BoolValue(isActive)
+	}
+
+	if isDefault, ok := GetBool(result, "is_default"); ok {
+		model.IsDefault = types.BoolValue(isDefault)
+	}
+
+	if className, ok := GetString(result, "class_name"); ok {
+		model.ClassName = types.StringValue(className)
+	} else if model.ClassName.IsUnknown() {
+		model.ClassName = types.StringNull()
+	}
+
+	model.UserName = r.client.optionalString(result, "user_name", model.UserName)
+
+	// Keep the configured secrets if the API does not return them
+	if _, ok := result["password"]; ok {
+		model.Password = r.client.optionalString(result, "password", model.Password)
+	}
+
+	if _, ok := result["signature"]; ok {
+		model.Signature = r.client.optionalString(result, "signature", model.Signature)
+	}
+
+	model.Subject = r.client.optionalString(result, "subject", model.Subject)
+
+	model.URLSite = r.client.optionalString(result, "url_site", model.URLSite)
+
+	model.URLAPI = r.client.optionalString(result, "url_api", model.URLAPI)
+
+	model.URLRecur = r.client.optionalString(result, "url_recur", model.URLRecur)
+
+	if domainID, ok := GetInt64(result, "domain_id"); ok {
+		model.DomainID = types.Int64Value(domainID)
+	} else if model.DomainID.IsUnknown() {
+		model.DomainID = types.Int64Null()
+	}
+}
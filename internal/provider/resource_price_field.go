@@ -0,0 +1,357 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &PriceFieldResource{}
+	_ resource.ResourceWithConfigure   = &PriceFieldResource{}
+	_ resource.ResourceWithImportState = &PriceFieldResource{}
+)
+
+// PriceFieldResource manages the fields of CiviCRM price sets.
+type PriceFieldResource struct {
+	client *Client
+}
+
+type PriceFieldResourceModel struct {
+	ID               types.Int64  `tfsdk:"id"`
+	PriceSetID       types.Int64  `tfsdk:"price_set_id"`
+	Name             types.String `tfsdk:"name"`
+	Label            types.String `tfsdk:"label"`
+	HTMLType         types.String `tfsdk:"html_type"`
+	IsRequired       types.Bool   `tfsdk:"is_required"`
+	Weight           types.Int64  `tfsdk:"weight"`
+	IsDisplayAmounts types.Bool   `tfsdk:"is_display_amounts"`
+	IsActive         types.Bool   `tfsdk:"is_active"`
+}
+
+// priceFieldSelect selects the PriceField fields that mapResponseToModel
+// reads.
+var priceFieldSelect = []string{
+	"id", "price_set_id", "name", "label", "html_type", "is_required",
+	"is_display_amounts", "weight", "is_active",
+}
+
+func NewPriceFieldResource() resource.Resource {
+	return &PriceFieldResource{}
+}
+
+func (r *PriceFieldResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_price_field"
+}
+
+func (r *PriceFieldResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a field of a CiviCRM price set, such as a ticket type selection or a donation amount.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier of the price field.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"price_set_id": schema.Int64Attribute{
+				Description: "The ID of the price set the field belongs to. Changing this forces a new price field.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The machine name of the price field. Derived from the label when not set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description: "The display label of the price field.",
+				Required:    true,
+			},
+			"html_type": schema.StringAttribute{
+				Description: "The input type of the price field. Options: 'Text', 'Select', 'Radio', 'CheckBox'.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Text", "Select", "Radio", "CheckBox"),
+				},
+			},
+			"is_required": schema.BoolAttribute{
+				Description: "Whether a selection is required. Default: true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"weight": managedWeightAttribute("price field"),
+			"is_display_amounts": schema.BoolAttribute{
+				Description: "Whether the amounts of the options are shown next to their labels. Default: true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"is_active": schema.BoolAttribute{
+				Description: "Whether the price field is active. Default: true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *PriceFieldResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PriceFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PriceFieldResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating price field", map[string]any{
+		"label":        plan.Label.ValueString(),
+		"price_set_id": plan.PriceSetID.ValueInt64(),
+	})
+
+	values := r.buildValues(plan)
+	values["price_set_id"] = plan.PriceSetID.ValueInt64()
+
+	if weight, ok := configuredWeight(ctx, req.Config, &resp.Diagnostics); ok {
+		values["weight"] = weight
+	}
+
+	// Call API
+	result, err := r.client.Create(ctx, "PriceField", values)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating price field",
+			"Could not create price field, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update state with response
+	r.mapResponseToModel(result, &plan)
+
+	tflog.Debug(ctx, "Created price field", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *PriceFieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PriceFieldResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading price field", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	result, err := r.client.GetByID(ctx, "PriceField", state.ID.ValueInt64(), priceFieldSelect)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Price field no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading price field",
+			"Could not read price field ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	r.mapResponseToModel(result, &state)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *PriceFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan PriceFieldResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state PriceFieldResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating price field", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	values := r.buildValues(plan)
+
+	if weight, ok := configuredWeight(ctx, req.Config, &resp.Diagnostics); ok {
+		values["weight"] = weight
+	}
+
+	// Call API
+	result, err := r.client.Update(ctx, "PriceField", state.ID.ValueInt64(), values)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating price field",
+			"Could not update price field ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	plan.ID = state.ID
+	r.mapResponseToModel(result, &plan)
+
+	tflog.Debug(ctx, "Updated price field", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *PriceFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PriceFieldResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting price field", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	err := r.client.Delete(ctx, "PriceField", state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting price field",
+			"Could not delete price field ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted price field", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+}
+
+func (r *PriceFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Could not parse import ID as integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// buildValues builds the API values shared by create and update. The weight
+// is added by the caller only when it is configured.
+func (r *PriceFieldResource) buildValues(plan PriceFieldResourceModel) map[string]any {
+	values := map[string]any{
+		"label":              plan.Label.ValueString(),
+		"html_type":          plan.HTMLType.ValueString(),
+		"is_required":        plan.IsRequired.ValueBool(),
+		"is_display_amounts": plan.IsDisplayAmounts.ValueBool(),
+		"is_active":          plan.IsActive.ValueBool(),
+	}
+
+	if !plan.Name.IsNull() && !plan.Name.IsUnknown() {
+		values["name"] = plan.Name.ValueString()
+	}
+
+	return values
+}
+
+func (r *PriceFieldResource) mapResponseToModel(result map[string]any, model *PriceFieldResourceModel) {
+	if id, ok := GetInt64(result, "id"); ok {
+		model.ID = types.Int64Value(id)
+	}
+
+	if priceSetID, ok := GetInt64(result, "price_set_id"); ok {
+		model.PriceSetID = types.Int64Value(priceSetID)
+	}
+
+	if name, ok := GetString(result, "name"); ok {
+		model.Name = types.StringValue(name)
+	} else if model.Name.IsUnknown() {
+		model.Name = types.StringNull()
+	}
+
+	if label, ok := GetString(result, "label"); ok {
+		model.Label = types.StringValue(label)
+	}
+
+	if htmlType, ok := GetString(result, "html_type"); ok {
+		model.HTMLType = types.StringValue(htmlType)
+	}
+
+	if isRequired, ok := GetBool(result, "is_required"); ok {
+		model.IsRequired = types.BoolValue(isRequired)
+	}
+
+	if weight, ok := GetInt64(result, "weight"); ok {
+		model.Weight = types.Int64Value(weight)
+	} else if model.Weight.IsUnknown() {
+		model.Weight = types.Int64Null()
+	}
+
+	if isDisplayAmounts, ok := GetBool(result, "is_display_amounts"); ok {
+		model.IsDisplayAmounts = types.BoolValue(isDisplayAmounts)
+	}
+
+	if isActive, ok := GetBool(result, "is_active"); ok {
+		model.IsActive = types.BoolValue(isActive)
+	}
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
@@ -16,9 +17,11 @@ import (
 )
 
 var (
-	_ resource.Resource                = &RelationshipTypeResource{}
-	_ resource.ResourceWithConfigure   = &RelationshipTypeResource{}
-	_ resource.ResourceWithImportState = &RelationshipTypeResource{}
+	_ resource.Resource                   = &RelationshipTypeResource{}
+	_ resource.ResourceWithConfigure      = &RelationshipTypeResource{}
+	_ resource.ResourceWithImportState    = &RelationshipTypeResource{}
+	_ resource.ResourceWithModifyPlan     = &RelationshipTypeResource{}
+	_ resource.ResourceWithValidateConfig = &RelationshipTypeResource{}
 )
 
 // RelationshipTypeResource manages relationship types in CiviCRM.
@@ -27,18 +30,28 @@ type RelationshipTypeResource struct {
 }
 
 type RelationshipTypeResourceModel struct {
-	ID              types.Int64  `tfsdk:"id"`
-	NameAB          types.String `tfsdk:"name_a_b"`
-	LabelAB         types.String `tfsdk:"label_a_b"`
-	NameBA          types.String `tfsdk:"name_b_a"`
-	LabelBA         types.String `tfsdk:"label_b_a"`
-	Description     types.String `tfsdk:"description"`
-	ContactTypeA    types.String `tfsdk:"contact_type_a"`
-	ContactTypeB    types.String `tfsdk:"contact_type_b"`
-	ContactSubTypeA types.String `tfsdk:"contact_sub_type_a"`
-	ContactSubTypeB types.String `tfsdk:"contact_sub_type_b"`
-	IsReserved      types.Bool   `tfsdk:"is_reserved"`
-	IsActive        types.Bool   `tfsdk:"is_active"`
+	ID                   types.Int64  `tfsdk:"id"`
+	NameAB               types.String `tfsdk:"name_a_b"`
+	LabelAB              types.String `tfsdk:"label_a_b"`
+	NameBA               types.String `tfsdk:"name_b_a"`
+	LabelBA              types.String `tfsdk:"label_b_a"`
+	Description          types.String `tfsdk:"description"`
+	ContactTypeA         types.String `tfsdk:"contact_type_a"`
+	ContactTypeB         types.String `tfsdk:"contact_type_b"`
+	ContactSubTypeA      types.String `tfsdk:"contact_sub_type_a"`
+	ContactSubTypeB      types.String `tfsdk:"contact_sub_type_b"`
+	IsReserved           types.Bool   `tfsdk:"is_reserved"`
+	IsActive             types.Bool   `tfsdk:"is_active"`
+	AllowReservedChanges types.Bool   `tfsdk:"allow_reserved_changes"`
+	IgnoreFields         types.List   `tfsdk:"ignore_fields"`
+}
+
+// relationshipTypeSelect selects the RelationshipType fields that
+// mapResponseToModel reads.
+var relationshipTypeSelect = []string{
+	"id", "name_a_b", "label_a_b", "name_b_a", "label_b_a", "description",
+	"contact_type_a", "contact_type_b", "contact_sub_type_a", "contact_sub_type_b",
+	"is_reserved", "is_active",
 }
 
 func NewRelationshipTypeResource() resource.Resource {
@@ -69,12 +82,16 @@ func (r *RelationshipTypeResource) Schema(ctx context.Context, req resource.Sche
 				Required:    true,
 			},
 			"name_b_a": schema.StringAttribute{
-				Description: "The relationship name from B to A perspective (e.g., 'Parent of').",
-				Required:    true,
+				Description: "The relationship name from B to A perspective (e.g., 'Parent of'). " +
+					"Defaults to name_a_b for symmetric relationships.",
+				Optional: true,
+				Computed: true,
 			},
 			"label_b_a": schema.StringAttribute{
-				Description: "The display label from B to A perspective.",
-				Required:    true,
+				Description: "The display label from B to A perspective. " +
+					"Defaults to label_a_b when name_b_a is also omitted, otherwise to name_b_a.",
+				Optional: true,
+				Computed: true,
 			},
 			"description": schema.StringAttribute{
 				Description: "A description of the relationship type.",
@@ -108,6 +125,8 @@ func (r *RelationshipTypeResource) Schema(ctx context.Context, req resource.Sche
 				Computed:    true,
 				Default:     booldefault.StaticBool(true),
 			},
+			"allow_reserved_changes": allowReservedChangesAttribute,
+			"ignore_fields":          ignoreFieldsAttribute,
 		},
 	}
 }
@@ -129,6 +148,62 @@ func (r *RelationshipTypeResource) Configure(ctx context.Context, req resource.C
 	r.client = client
 }
 
+// ValidateConfig rejects ignore_fields entries that name no attribute or one
+// that cannot be ignored.
+func (r *RelationshipTypeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateIgnoredFields(ctx, req.Config, &resp.Diagnostics)
+}
+
+// ModifyPlan fills in the B-A side defaults and protects reserved relationship
+// types from accidental changes.
+func (r *RelationshipTypeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.planBADefaults(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkReservedChange(ctx, req, resp, "relationship type")
+}
+
+// planBADefaults fills in name_b_a and label_b_a when they are omitted, so that
+// symmetric relationship types only need the A-B side configured.
+func (r *RelationshipTypeResource) planBADefaults(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config RelationshipTypeResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan RelationshipTypeResourceModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameBA := plan.NameBA
+	labelBA := plan.LabelBA
+
+	if config.NameBA.IsNull() {
+		// Symmetric relationship: mirror the A-B side
+		nameBA = plan.NameAB
+		if config.LabelBA.IsNull() {
+			labelBA = plan.LabelAB
+		}
+	} else if config.LabelBA.IsNull() {
+		labelBA = config.NameBA
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("name_b_a"), nameBA)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("label_b_a"), labelBA)...)
+}
+
 func (r *RelationshipTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan RelationshipTypeResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -172,7 +247,7 @@ func (r *RelationshipTypeResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	// Call API
-	result, err := r.client.Create("RelationshipType", values)
+	result, err := r.client.Create(ctx, "RelationshipType", values)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating relationship type",
@@ -204,7 +279,14 @@ func (r *RelationshipTypeResource) Read(ctx context.Context, req resource.ReadRe
 		"id": state.ID.ValueInt64(),
 	})
 
-	result, err := r.client.GetByID("RelationshipType", state.ID.ValueInt64(), nil)
+	result, err := r.client.GetByID(ctx, "RelationshipType", state.ID.ValueInt64(), relationshipTypeSelect)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Relationship type no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading relationship type",
@@ -218,6 +300,10 @@ func (r *RelationshipTypeResource) Read(ctx context.Context, req resource.ReadRe
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+
+	// Keep the prior value of attributes managed outside of Terraform
+	ignored := ignoredFields(ctx, state.IgnoreFields, &resp.Diagnostics)
+	keepIgnoredFields(ctx, ignored, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *RelationshipTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -279,8 +365,14 @@ func (r *RelationshipTypeResource) Update(ctx context.Context, req resource.Upda
 		values["contact_sub_type_b"] = nil
 	}
 
+	ignored := ignoredFields(ctx, plan.IgnoreFields, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	omitIgnoredFields(values, ignored, nil)
+
 	// Call API
-	result, err := r.client.Update("RelationshipType", state.ID.ValueInt64(), values)
+	result, err := r.client.Update(ctx, "RelationshipType", state.ID.ValueInt64(), values)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating relationship type",
@@ -299,6 +391,8 @@ func (r *RelationshipTypeResource) Update(ctx context.Context, req resource.Upda
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	keepIgnoredFields(ctx, ignored, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *RelationshipTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -313,7 +407,7 @@ func (r *RelationshipTypeResource) Delete(ctx context.Context, req resource.Dele
 		"id": state.ID.ValueInt64(),
 	})
 
-	err := r.client.Delete("RelationshipType", state.ID.ValueInt64())
+	err := r.client.Delete(ctx, "RelationshipType", state.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting relationship type",
@@ -338,6 +432,7 @@ func (r *RelationshipTypeResource) ImportState(ctx context.Context, req resource
 	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("allow_reserved_changes"), false)...)
 }
 
 func (r *RelationshipTypeResource) mapResponseToModel(result map[string]any, model *RelationshipTypeResourceModel) {
@@ -361,35 +456,15 @@ func (r *RelationshipTypeResource) mapResponseToModel(result map[string]any, mod
 		model.LabelBA = types.StringValue(labelBA)
 	}
 
-	if description, ok := GetString(result, "description"); ok && description != "" {
-		model.Description = types.StringValue(description)
-	} else {
-		model.Description = types.StringNull()
-	}
+	model.Description = r.client.optionalString(result, "description", model.Description)
 
-	if contactTypeA, ok := GetString(result, "contact_type_a"); ok && contactTypeA != "" {
-		model.ContactTypeA = types.StringValue(contactTypeA)
-	} else {
-		model.ContactTypeA = types.StringNull()
-	}
+	model.ContactTypeA = r.client.optionalString(result, "contact_type_a", model.ContactTypeA)
 
-	if contactTypeB, ok := GetString(result, "contact_type_b"); ok && contactTypeB != "" {
-		model.ContactTypeB = types.StringValue(contactTypeB)
-	} else {
-		model.ContactTypeB = types.StringNull()
-	}
+	model.ContactTypeB = r.client.optionalString(result, "contact_type_b", model.ContactTypeB)
 
-	if contactSubTypeA, ok := GetString(result, "contact_sub_type_a"); ok && contactSubTypeA != "" {
-		model.ContactSubTypeA = types.StringValue(contactSubTypeA)
-	} else {
-		model.ContactSubTypeA = types.StringNull()
-	}
+	model.ContactSubTypeA = r.client.optionalString(result, "contact_sub_type_a", model.ContactSubTypeA)
 
-	if contactSubTypeB, ok := GetString(result, "contact_sub_type_b"); ok && contactSubTypeB != "" {
-		model.ContactSubTypeB = types.StringValue(contactSubTypeB)
-	} else {
-		model.ContactSubTypeB = types.StringNull()
-	}
+	model.ContactSubTypeB = r.client.optionalString(result, "contact_sub_type_b", model.ContactSubTypeB)
 
 	if isReserved, ok := GetBool(result, "is_reserved"); ok {
 		model.IsReserved = types.BoolValue(isReserved)
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRelationshipTypeResourceModifyPlanBADefaults(t *testing.T) {
+	tests := []struct {
+		name        string
+		nameBA      types.String
+		labelBA     types.String
+		wantNameBA  string
+		wantLabelBA string
+	}{
+		{
+			name:        "both omitted",
+			nameBA:      types.StringNull(),
+			labelBA:     types.StringNull(),
+			wantNameBA:  "Sibling of",
+			wantLabelBA: "Sibling",
+		},
+		{
+			name:        "label omitted",
+			nameBA:      types.StringValue("Parent of"),
+			labelBA:     types.StringNull(),
+			wantNameBA:  "Parent of",
+			wantLabelBA: "Parent of",
+		},
+		{
+			name:        "name omitted",
+			nameBA:      types.StringNull(),
+			labelBA:     types.StringValue("Sister"),
+			wantNameBA:  "Sibling of",
+			wantLabelBA: "Sister",
+		},
+		{
+			name:        "both set",
+			nameBA:      types.StringValue("Parent of"),
+			labelBA:     types.StringValue("Parent"),
+			wantNameBA:  "Parent of",
+			wantLabelBA: "Parent",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			r := &RelationshipTypeResource{}
+			s := newTestResource(t, r, nil)
+
+			config := RelationshipTypeResourceModel{
+				ID:                   types.Int64Null(),
+				NameAB:               types.StringValue("Sibling of"),
+				LabelAB:              types.StringValue("Sibling"),
+				NameBA:               tt.nameBA,
+				LabelBA:              tt.labelBA,
+				Description:          types.StringNull(),
+				ContactTypeA:         types.StringNull(),
+				ContactTypeB:         types.StringNull(),
+				ContactSubTypeA:      types.StringNull(),
+				ContactSubTypeB:      types.StringNull(),
+				IsReserved:           types.BoolNull(),
+				IsActive:             types.BoolNull(),
+				AllowReservedChanges: types.BoolNull(),
+				IgnoreFields:         types.ListNull(types.StringType),
+			}
+
+			plan := config
+			plan.ID = types.Int64Unknown()
+			plan.IsReserved = types.BoolValue(false)
+			plan.IsActive = types.BoolValue(true)
+			plan.AllowReservedChanges = types.BoolValue(false)
+			if plan.NameBA.IsNull() {
+				plan.NameBA = types.StringUnknown()
+			}
+			if plan.LabelBA.IsNull() {
+				plan.LabelBA = types.StringUnknown()
+			}
+
+			req := resource.ModifyPlanRequest{
+				Config: testConfig(t, s, config),
+				Plan:   testPlan(t, s, plan),
+				State:  emptyTestState(s),
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan: %v", resp.Diagnostics)
+			}
+
+			var got RelationshipTypeResourceModel
+			resp.Diagnostics.Append(resp.Plan.Get(ctx, &got)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("failed to read plan: %v", resp.Diagnostics)
+			}
+			if got.NameBA.ValueString() != tt.wantNameBA {
+				t.Errorf("name_b_a = %s, want %q", got.NameBA, tt.wantNameBA)
+			}
+			if got.LabelBA.ValueString() != tt.wantLabelBA {
+				t.Errorf("label_b_a = %s, want %q", got.LabelBA, tt.wantLabelBA)
+			}
+		})
+	}
+}
+
+func TestRelationshipTypeResourceModifyPlanDestroy(t *testing.T) {
+	r := &RelationshipTypeResource{}
+	s := newTestResource(t, r, nil)
+
+	req := resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: s, Raw: emptyTestState(s).Raw},
+		Plan:   tfsdk.Plan{Schema: s, Raw: emptyTestState(s).Raw},
+		State:  emptyTestState(s),
+	}
+	resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(context.Background(), req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("ModifyPlan: %v", resp.Diagnostics)
+	}
+	if !resp.Plan.Raw.IsNull() {
+		t.Errorf("plan = %s, want null on destroy", resp.Plan.Raw)
+	}
+}
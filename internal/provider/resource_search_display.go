@@ -0,0 +1,369 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &SearchDisplayResource{}
+	_ resource.ResourceWithConfigure      = &SearchDisplayResource{}
+	_ resource.ResourceWithImportState    = &SearchDisplayResource{}
+	_ resource.ResourceWithValidateConfig = &SearchDisplayResource{}
+)
+
+// SearchDisplayResource manages SearchKit displays of saved searches.
+type SearchDisplayResource struct {
+	client *Client
+}
+
+type SearchDisplayResourceModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	SavedSearchID types.Int64  `tfsdk:"saved_search_id"`
+	Name          types.String `tfsdk:"name"`
+	Label         types.String `tfsdk:"label"`
+	Type          types.String `tfsdk:"type"`
+	Settings      types.String `tfsdk:"settings"`
+	ACLBypass     types.Bool   `tfsdk:"acl_bypass"`
+}
+
+// searchDisplaySelect selects the SearchDisplay fields that
+// mapResponseToModel reads.
+var searchDisplaySelect = []string{"id", "name", "label", "saved_search_id", "type", "settings", "acl_bypass"}
+
+func NewSearchDisplayResource() resource.Resource {
+	return &SearchDisplayResource{}
+}
+
+func (r *SearchDisplayResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_search_display"
+}
+
+func (r *SearchDisplayResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a SearchKit display, such as a table or list, of a CiviCRM saved search. Requires the SearchKit extension.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier of the search display.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"saved_search_id": schema.Int64Attribute{
+				Description: "The ID of the saved search the display shows. Changing this forces a new search display.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The machine name of the search display (must be unique within the saved search).",
+				Required:    true,
+			},
+			"label": schema.StringAttribute{
+				Description: "The display label of the search display.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of display. Options: 'table', 'list', 'grid', 'autocomplete', 'entity', 'batch'.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("table", "list", "grid", "autocomplete", "entity", "batch"),
+				},
+			},
+			"settings": schema.StringAttribute{
+				Description: "The display settings (columns, sorting, pager, actions), encoded as JSON (use jsonencode()). Copy them from the SearchKit UI or the API explorer.",
+				Optional:    true,
+			},
+			"acl_bypass": schema.BoolAttribute{
+				Description: "Whether the display skips permission checks, showing all results to everyone who can see it. Default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// ValidateConfig rejects settings that are not a JSON object.
+func (r *SearchDisplayResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SearchDisplayResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Settings.IsNull() || config.Settings.IsUnknown() {
+		return
+	}
+
+	var settings map[string]any
+	if err := json.Unmarshal([]byte(config.Settings.ValueString()), &settings); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("settings"),
+			"Invalid search display settings",
+			"settings must be a JSON object, e.g. jsonencode({ columns = [] }): "+err.Error(),
+		)
+	}
+}
+
+func (r *SearchDisplayResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SearchDisplayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SearchDisplayResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating search display", map[string]any{
+		"name":            plan.Name.ValueString(),
+		"saved_search_id": plan.SavedSearchID.ValueInt64(),
+	})
+
+	values := r.buildValues(plan, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	values["saved_search_id"] = plan.SavedSearchID.ValueInt64()
+
+	// Call API
+	result, err := r.client.Create(ctx, "SearchDisplay", values)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating search display",
+			"Could not create search display, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update state with response
+	r.mapResponseToModel(result, &plan, &resp.Diagnostics)
+
+	tflog.Debug(ctx, "Created search display", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *SearchDisplayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SearchDisplayResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading search display", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	result, err := r.client.GetByID(ctx, "SearchDisplay", state.ID.ValueInt64(), searchDisplaySelect)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Search display no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading search display",
+			"Could not read search display ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	r.mapResponseToModel(result, &state, &resp.Diagnostics)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *SearchDisplayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SearchDisplayResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state SearchDisplayResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating search display", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	values := r.buildValues(plan, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call API
+	result, err := r.client.Update(ctx, "SearchDisplay", state.ID.ValueInt64(), values)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating search display",
+			"Could not update search display ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state
+	plan.ID = state.ID
+	r.mapResponseToModel(result, &plan, &resp.Diagnostics)
+
+	tflog.Debug(ctx, "Updated search display", map[string]any{
+		"id": plan.ID.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *SearchDisplayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SearchDisplayResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting search display", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+
+	err := r.client.Delete(ctx, "SearchDisplay", state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting search display",
+			"Could not delete search display ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted search display", map[string]any{
+		"id": state.ID.ValueInt64(),
+	})
+}
+
+func (r *SearchDisplayResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Could not parse import ID as integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// buildValues builds the API values for plan, decoding the JSON settings. On
+// update, null settings are sent as an empty object so that they are cleared.
+func (r *SearchDisplayResource) buildValues(plan SearchDisplayResourceModel, update bool, diags *diag.Diagnostics) map[string]any {
+	values := map[string]any{
+		"name":       plan.Name.ValueString(),
+		"label":      plan.Label.ValueString(),
+		"type":       plan.Type.ValueString(),
+		"acl_bypass": plan.ACLBypass.ValueBool(),
+	}
+
+	if !plan.Settings.IsNull() {
+		var settings map[string]any
+		if err := json.Unmarshal([]byte(plan.Settings.ValueString()), &settings); err != nil {
+			diags.AddAttributeError(
+				path.Root("settings"),
+				"Invalid search display settings",
+				"settings must be a JSON object: "+err.Error(),
+			)
+			return nil
+		}
+		values["settings"] = settings
+	} else if update {
+		values["settings"] = map[string]any{}
+	}
+
+	return values
+}
+
+func (r *SearchDisplayResource) mapResponseToModel(result map[string]any, model *SearchDisplayResourceModel, diags *diag.Diagnostics) {
+	if id, ok := GetInt64(result, "id"); ok {
+		model.ID = types.Int64Value(id)
+	}
+
+	if savedSearchID, ok := GetInt64(result, "saved_search_id"); ok {
+		model.SavedSearchID = types.Int64Value(savedSearchID)
+	}
+
+	if name, ok := GetString(result, "name"); ok {
+		model.Name = types.StringValue(name)
+	}
+
+	if label, ok := GetString(result, "label"); ok {
+		model.Label = types.StringValue(label)
+	}
+
+	if displayType, ok := GetString(result, "type"); ok {
+		model.Type = types.StringValue(displayType)
+	}
+
+	// Keep the configured JSON formatting unless the settings changed. Empty
+	// settings match a configuration without settings.
+	settings, _ := result["settings"].(map[string]any)
+	switch {
+	case len(settings) == 0 && model.Settings.IsNull():
+	case !model.Settings.IsNull() && jsonValueEquals(model.Settings.ValueString(), settings):
+	default:
+		encoded, err := json.Marshal(settings)
+		if err != nil {
+			diags.AddError(
+				"Error reading search display",
+				"Could not encode search display settings: "+err.Error(),
+			)
+			return
+		}
+		model.Settings = types.StringValue(string(encoded))
+	}
+
+	if aclBypass, ok := GetBool(result, "acl_bypass"); ok {
+		model.ACLBypass = types.BoolValue(aclBypass)
+	}
+}
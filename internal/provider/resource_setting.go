@@ -0,0 +1,318 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &SettingResource{}
+	_ resource.ResourceWithConfigure      = &SettingResource{}
+	_ resource.ResourceWithImportState    = &SettingResource{}
+	_ resource.ResourceWithValidateConfig = &SettingResource{}
+)
+
+// SettingResource manages a single CiviCRM setting through the Setting API.
+// Settings are not entities: they always exist, so creating the resource sets
+// the value and destroying it reverts the setting to its default, unless
+// revert_on_delete is false.
+type SettingResource struct {
+	client *Client
+}
+
+type SettingResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Value          types.String `tfsdk:"value"`
+	DomainID       types.Int64  `tfsdk:"domain_id"`
+	RevertOnDelete types.Bool   `tfsdk:"revert_on_delete"`
+}
+
+func NewSettingResource() resource.Resource {
+	return &SettingResource{}
+}
+
+func (r *SettingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_setting"
+}
+
+func (r *SettingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a CiviCRM setting, such as 'mailerBatchLimit' or 'enableSSL'. Destroying the resource reverts the setting to its default unless revert_on_delete is false.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier of the setting: its name, prefixed with the domain ID and '/' when domain_id is set.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the setting. Changing this forces a new resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "The value of the setting, encoded as JSON (use jsonencode()).",
+				Required:    true,
+			},
+			"domain_id": schema.Int64Attribute{
+				Description: "The domain the setting applies to. Defaults to the current domain. Changing this forces a new resource.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"revert_on_delete": schema.BoolAttribute{
+				Description: "Whether destroying the resource reverts the setting to its CiviCRM default. When false, the setting keeps the last applied value and is only removed from the Terraform state. Default: true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+// ValidateConfig rejects values that are not valid JSON.
+func (r *SettingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SettingResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Value.IsNull() || config.Value.IsUnknown() {
+		return
+	}
+
+	if !json.Valid([]byte(config.Value.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("value"),
+			"Invalid setting value",
+			"value must be valid JSON, e.g. jsonencode(100) or jsonencode(true), got: "+config.Value.ValueString(),
+		)
+	}
+}
+
+func (r *SettingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SettingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SettingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating setting", map[string]any{
+		"name": plan.Name.ValueString(),
+	})
+
+	if err := r.set(ctx, plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating setting",
+			"Could not set setting "+plan.Name.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(settingID(plan.Name.ValueString(), plan.DomainID))
+
+	tflog.Debug(ctx, "Created setting", map[string]any{
+		"id": plan.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *SettingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SettingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading setting", map[string]any{
+		"id": state.ID.ValueString(),
+	})
+
+	value, err := r.client.GetSetting(ctx, state.Name.ValueString(), state.DomainID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading setting",
+			"Could not read setting "+state.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Keep the configured JSON formatting unless the value itself changed
+	if !jsonValueEquals(state.Value.ValueString(), value) {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading setting",
+				"Could not encode value of setting "+state.Name.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		state.Value = types.StringValue(string(encoded))
+	}
+
+	state.ID = types.StringValue(settingID(state.Name.ValueString(), state.DomainID))
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *SettingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SettingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating setting", map[string]any{
+		"name": plan.Name.ValueString(),
+	})
+
+	if err := r.set(ctx, plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating setting",
+			"Could not set setting "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(settingID(plan.Name.ValueString(), plan.DomainID))
+
+	tflog.Debug(ctx, "Updated setting", map[string]any{
+		"id": plan.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *SettingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SettingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting setting", map[string]any{
+		"id": state.ID.ValueString(),
+	})
+
+	// A null value, e.g. after an import, reverts like the default
+	if !state.RevertOnDelete.IsNull() && !state.RevertOnDelete.ValueBool() {
+		tflog.Debug(ctx, "Leaving setting at its current value", map[string]any{
+			"id": state.ID.ValueString(),
+		})
+		return
+	}
+
+	err := r.client.RevertSetting(ctx, state.Name.ValueString(), state.DomainID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting setting",
+			"Could not revert setting "+state.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted setting", map[string]any{
+		"id": state.ID.ValueString(),
+	})
+}
+
+// ImportState accepts the setting name, or "domain_id/name" for a setting of
+// another domain.
+func (r *SettingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	name := req.ID
+	if domain, rest, found := strings.Cut(req.ID, "/"); found {
+		domainID, err := strconv.ParseInt(domain, 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid import ID",
+				"Expected import ID in the format 'name' or 'domain_id/name', could not parse domain_id as integer: "+err.Error(),
+			)
+			return
+		}
+		name = rest
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_id"), domainID)...)
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("revert_on_delete"), true)...)
+}
+
+// set decodes the JSON value of plan and writes it to the setting.
+func (r *SettingResource) set(ctx context.Context, plan SettingResourceModel) error {
+	var value any
+	if err := json.Unmarshal([]byte(plan.Value.ValueString()), &value); err != nil {
+		return fmt.Errorf("value is not valid JSON: %w", err)
+	}
+
+	return r.client.SetSetting(ctx, plan.Name.ValueString(), value, plan.DomainID.ValueInt64())
+}
+
+// settingID builds the resource ID from the setting name and domain.
+func settingID(name string, domainID types.Int64) string {
+	if domainID.IsNull() {
+		return name
+	}
+	return strconv.FormatInt(domainID.ValueInt64(), 10) + "/" + name
+}
+
+// jsonValueEquals reports whether the JSON in encoded decodes to value.
+func jsonValueEquals(encoded string, value any) bool {
+	var decoded any
+	if err := json.Unmarshal([]byte(encoded), &decoded); err != nil {
+		return false
+	}
+
+	// Round-trip value so that numbers and nested types compare the same way
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return false
+	}
+	var normalized any
+	if err := json.Unmarshal(raw, &normalized); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(decoded, normalized)
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSettingResourceDelete(t *testing.T) {
+	tests := []struct {
+		name           string
+		revertOnDelete types.Bool
+		wantReverted   bool
+	}{
+		{name: "revert", revertOnDelete: types.BoolValue(true), wantReverted: true},
+		{name: "leave as is", revertOnDelete: types.BoolValue(false)},
+		{name: "null", revertOnDelete: types.BoolNull(), wantReverted: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var reverted bool
+			client := newTestClient(t, func(req testRequest) ([]map[string]any, error) {
+				if req.Entity+"."+req.Action == "Setting.revert" {
+					reverted = true
+					if sel, _ := req.Params["select"].([]any); len(sel) != 1 || sel[0] != "mailerBatchLimit" {
+						t.Errorf("Setting.revert select = %v, want [mailerBatchLimit]", req.Params["select"])
+					}
+					return nil, nil
+				}
+				t.Errorf("unexpected request %s.%s", req.Entity, req.Action)
+				return nil, errors.New("unexpected request")
+			})
+
+			r := &SettingResource{}
+			s := newTestResource(t, r, client)
+
+			state := testState(t, s, SettingResourceModel{
+				ID:             types.StringValue("mailerBatchLimit"),
+				Name:           types.StringValue("mailerBatchLimit"),
+				Value:          types.StringValue("500"),
+				DomainID:       types.Int64Null(),
+				RevertOnDelete: tt.revertOnDelete,
+			})
+
+			resp := &resource.DeleteResponse{State: state}
+			r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Delete: %v", resp.Diagnostics)
+			}
+			if reverted != tt.wantReverted {
+				t.Errorf("reverted = %t, want %t", reverted, tt.wantReverted)
+			}
+		})
+	}
+}
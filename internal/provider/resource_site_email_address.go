@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
@@ -36,6 +37,11 @@ type SiteEmailAddressResourceModel struct {
 	DomainID    types.Int64  `tfsdk:"domain_id"`
 }
 
+// siteEmailAddressSelect selects the SiteEmailAddress fields that Read uses.
+var siteEmailAddressSelect = []string{
+	"id", "display_name", "email", "description", "is_active", "is_default", "domain_id",
+}
+
 func NewSiteEmailAddressResource() resource.Resource {
 	return &SiteEmailAddressResource{}
 }
@@ -135,7 +141,7 @@ func (r *SiteEmailAddressResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	// Call API
-	result, err := r.client.Create("SiteEmailAddress", values)
+	result, err := r.client.Create(ctx, "SiteEmailAddress", values)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating site email address",
@@ -157,11 +163,7 @@ func (r *SiteEmailAddressResource) Create(ctx context.Context, req resource.Crea
 		plan.Email = types.StringValue(email)
 	}
 
-	if description, ok := GetString(result, "description"); ok && description != "" {
-		plan.Description = types.StringValue(description)
-	} else {
-		plan.Description = types.StringNull()
-	}
+	plan.Description = r.client.optionalString(result, "description", plan.Description)
 
 	if isActive, ok := GetBool(result, "is_active"); ok {
 		plan.IsActive = types.BoolValue(isActive)
@@ -195,7 +197,14 @@ func (r *SiteEmailAddressResource) Read(ctx context.Context, req resource.ReadRe
 		"id": state.ID.ValueInt64(),
 	})
 
-	result, err := r.client.GetByID("SiteEmailAddress", state.ID.ValueInt64(), nil)
+	result, err := r.client.GetByID(ctx, "SiteEmailAddress", state.ID.ValueInt64(), siteEmailAddressSelect)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Site email address no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading site email address",
@@ -213,11 +222,7 @@ func (r *SiteEmailAddressResource) Read(ctx context.Context, req resource.ReadRe
 		state.Email = types.StringValue(email)
 	}
 
-	if description, ok := GetString(result, "description"); ok && description != "" {
-		state.Description = types.StringValue(description)
-	} else {
-		state.Description = types.StringNull()
-	}
+	state.Description = r.client.optionalString(result, "description", state.Description)
 
 	if isActive, ok := GetBool(result, "is_active"); ok {
 		state.IsActive = types.BoolValue(isActive)
@@ -273,7 +278,7 @@ func (r *SiteEmailAddressResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	// Call API
-	result, err := r.client.Update("SiteEmailAddress", state.ID.ValueInt64(), values)
+	result, err := r.client.Update(ctx, "SiteEmailAddress", state.ID.ValueInt64(), values)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating site email address",
@@ -293,11 +298,7 @@ func (r *SiteEmailAddressResource) Update(ctx context.Context, req resource.Upda
 		plan.Email = types.StringValue(email)
 	}
 
-	if description, ok := GetString(result, "description"); ok && description != "" {
-		plan.Description = types.StringValue(description)
-	} else {
-		plan.Description = types.StringNull()
-	}
+	plan.Description = r.client.optionalString(result, "description", plan.Description)
 
 	if isActive, ok := GetBool(result, "is_active"); ok {
 		plan.IsActive = types.BoolValue(isActive)
@@ -331,7 +332,7 @@ func (r *SiteEmailAddressResource) Delete(ctx context.Context, req resource.Dele
 		"id": state.ID.ValueInt64(),
 	})
 
-	err := r.client.Delete("SiteEmailAddress", state.ID.ValueInt64())
+	err := r.client.Delete(ctx, "SiteEmailAddress", state.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting site email address",
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
@@ -17,9 +18,11 @@ import (
 )
 
 var (
-	_ resource.Resource                = &TagResource{}
-	_ resource.ResourceWithConfigure   = &TagResource{}
-	_ resource.ResourceWithImportState = &TagResource{}
+	_ resource.Resource                   = &TagResource{}
+	_ resource.ResourceWithConfigure      = &TagResource{}
+	_ resource.ResourceWithImportState    = &TagResource{}
+	_ resource.ResourceWithModifyPlan     = &TagResource{}
+	_ resource.ResourceWithValidateConfig = &TagResource{}
 )
 
 // TagResource manages tags in CiviCRM.
@@ -28,16 +31,24 @@ type TagResource struct {
 }
 
 type TagResourceModel struct {
-	ID           types.Int64  `tfsdk:"id"`
-	Name         types.String `tfsdk:"name"`
-	Label        types.String `tfsdk:"label"`
-	Description  types.String `tfsdk:"description"`
-	ParentID     types.Int64  `tfsdk:"parent_id"`
-	IsSelectable types.Bool   `tfsdk:"is_selectable"`
-	IsReserved   types.Bool   `tfsdk:"is_reserved"`
-	IsTagset     types.Bool   `tfsdk:"is_tagset"`
-	UsedFor      types.List   `tfsdk:"used_for"`
-	Color        types.String `tfsdk:"color"`
+	ID                   types.Int64  `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Label                types.String `tfsdk:"label"`
+	Description          types.String `tfsdk:"description"`
+	ParentID             types.Int64  `tfsdk:"parent_id"`
+	IsSelectable         types.Bool   `tfsdk:"is_selectable"`
+	IsReserved           types.Bool   `tfsdk:"is_reserved"`
+	IsTagset             types.Bool   `tfsdk:"is_tagset"`
+	UsedFor              types.List   `tfsdk:"used_for"`
+	Color                types.String `tfsdk:"color"`
+	AllowReservedChanges types.Bool   `tfsdk:"allow_reserved_changes"`
+	IgnoreFields         types.List   `tfsdk:"ignore_fields"`
+}
+
+// tagSelect selects the Tag fields that mapResponseToModel reads.
+var tagSelect = []string{
+	"id", "name", "label", "description", "parent_id", "is_selectable", "is_reserved",
+	"is_tagset", "used_for", "color",
 }
 
 func NewTagResource() resource.Resource {
@@ -103,6 +114,8 @@ func (r *TagResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 				Description: "The color for the tag in hex format (e.g., '#ff0000').",
 				Optional:    true,
 			},
+			"allow_reserved_changes": allowReservedChangesAttribute,
+			"ignore_fields":          ignoreFieldsAttribute,
 		},
 	}
 }
@@ -124,6 +137,17 @@ func (r *TagResource) Configure(ctx context.Context, req resource.ConfigureReque
 	r.client = client
 }
 
+// ValidateConfig rejects ignore_fields entries that name no attribute or one
+// that cannot be ignored.
+func (r *TagResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateIgnoredFields(ctx, req.Config, &resp.Diagnostics)
+}
+
+// ModifyPlan protects reserved tags from accidental changes.
+func (r *TagResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkReservedChange(ctx, req, resp, "tag")
+}
+
 func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan TagResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -153,6 +177,14 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 	}
 
 	if !plan.ParentID.IsNull() {
+		if err := r.validateParent(ctx, 0, plan.ParentID.ValueInt64()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("parent_id"),
+				"Invalid parent tag",
+				err.Error(),
+			)
+			return
+		}
 		values["parent_id"] = plan.ParentID.ValueInt64()
 	}
 
@@ -171,7 +203,7 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 	}
 
 	// Call API
-	result, err := r.client.Create("Tag", values)
+	result, err := r.client.Create(ctx, "Tag", values)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating tag",
@@ -205,7 +237,14 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		"id": state.ID.ValueInt64(),
 	})
 
-	result, err := r.client.GetByID("Tag", state.ID.ValueInt64(), nil)
+	result, err := r.client.GetByID(ctx, "Tag", state.ID.ValueInt64(), tagSelect)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Tag no longer exists, removing from state", map[string]any{
+			"id": state.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading tag",
@@ -221,6 +260,10 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+
+	// Keep the prior value of attributes managed outside of Terraform
+	ignored := ignoredFields(ctx, state.IgnoreFields, &resp.Diagnostics)
+	keepIgnoredFields(ctx, ignored, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -263,6 +306,14 @@ func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	}
 
 	if !plan.ParentID.IsNull() {
+		if err := r.validateParent(ctx, state.ID.ValueInt64(), plan.ParentID.ValueInt64()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("parent_id"),
+				"Invalid parent tag",
+				err.Error(),
+			)
+			return
+		}
 		values["parent_id"] = plan.ParentID.ValueInt64()
 	} else {
 		values["parent_id"] = nil
@@ -286,8 +337,14 @@ func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		values["color"] = nil
 	}
 
+	ignored := ignoredFields(ctx, plan.IgnoreFields, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	omitIgnoredFields(values, ignored, nil)
+
 	// Call API
-	result, err := r.client.Update("Tag", state.ID.ValueInt64(), values)
+	result, err := r.client.Update(ctx, "Tag", state.ID.ValueInt64(), values)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating tag",
@@ -308,6 +365,8 @@ func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	keepIgnoredFields(ctx, ignored, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -322,7 +381,7 @@ func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		"id": state.ID.ValueInt64(),
 	})
 
-	err := r.client.Delete("Tag", state.ID.ValueInt64())
+	err := r.client.Delete(ctx, "Tag", state.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting tag",
@@ -347,6 +406,7 @@ func (r *TagResource) ImportState(ctx context.Context, req resource.ImportStateR
 	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("allow_reserved_changes"), false)...)
 }
 
 func (r *TagResource) mapResponseToModel(ctx context.Context, result map[string]any, model *TagResourceModel, diags *diag.Diagnostics) {
@@ -367,11 +427,7 @@ func (r *TagResource) mapResponseToModel(ctx context.Context, result map[string]
 		}
 	}
 
-	if description, ok := GetString(result, "description"); ok && description != "" {
-		model.Description = types.StringValue(description)
-	} else {
-		model.Description = types.StringNull()
-	}
+	model.Description = r.client.optionalString(result, "description", model.Description)
 
 	if parentID, ok := GetInt64(result, "parent_id"); ok {
 		model.ParentID = types.Int64Value(parentID)
@@ -414,9 +470,47 @@ func (r *TagResource) mapResponseToModel(ctx context.Context, result map[string]
 		model.UsedFor = types.ListNull(types.StringType)
 	}
 
-	if color, ok := GetString(result, "color"); ok && color != "" {
-		model.Color = types.StringValue(color)
-	} else {
-		model.Color = types.StringNull()
+	model.Color = r.client.optionalString(result, "color", model.Color)
+}
+
+// maxTagDepth limits how deep a tag hierarchy may nest. CiviCRM does not
+// enforce a limit itself, but deeply nested tag trees are hard to use in the
+// tag selector and usually point at a misconfigured parent_id.
+const maxTagDepth = 5
+
+// validateParent checks that parentID can be the parent of the tag with the
+// given ID (0 for a new tag). It walks up the parent chain so that a tag can
+// not be moved below one of its own descendants and the hierarchy stays within
+// maxTagDepth levels.
+func (r *TagResource) validateParent(ctx context.Context, id, parentID int64) error {
+	if id != 0 && id == parentID {
+		return fmt.Errorf("a tag cannot be its own parent")
+	}
+
+	depth := 1
+	current := parentID
+	for {
+		tag, err := r.client.GetByID(ctx, "Tag", current, []string{"id", "name", "parent_id"})
+		if err != nil {
+			if current == parentID {
+				return fmt.Errorf("could not look up parent tag %d: %w", parentID, err)
+			}
+			return fmt.Errorf("could not look up ancestor tag %d: %w", current, err)
+		}
+
+		depth++
+		if depth > maxTagDepth {
+			return fmt.Errorf("nesting below tag %d would exceed the maximum tag depth of %d levels", parentID, maxTagDepth)
+		}
+
+		next, ok := GetInt64(tag, "parent_id")
+		if !ok {
+			return nil
+		}
+		if id != 0 && next == id {
+			name, _ := GetString(tag, "name")
+			return fmt.Errorf("tag %d (%s) is a descendant of tag %d; moving it below its own descendant would create a cycle", current, name, id)
+		}
+		current = next
 	}
 }
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testTagTree answers Tag.get requests by ID from a tree of tags, given as the
+// parent ID of each tag (0 for a root tag).
+func testTagTree(t *testing.T, parents map[int64]int64) testHandler {
+	return func(req testRequest) ([]map[string]any, error) {
+		if req.Entity+"."+req.Action != "Tag.get" {
+			t.Errorf("unexpected request %s.%s", req.Entity, req.Action)
+			return nil, errors.New("unexpected request")
+		}
+
+		where, _ := req.Params["where"].([]any)
+		clause, _ := where[0].([]any)
+		id := int64(clause[2].(float64))
+
+		parent, ok := parents[id]
+		if !ok {
+			return nil, nil
+		}
+		tag := map[string]any{"id": id, "name": fmt.Sprintf("tag_%d", id), "parent_id": nil}
+		if parent != 0 {
+			tag["parent_id"] = parent
+		}
+		return []map[string]any{tag}, nil
+	}
+}
+
+func TestTagResourceValidateParent(t *testing.T) {
+	// 1 <- 2 <- 3 <- 4 <- 5, and 6 on its own
+	parents := map[int64]int64{1: 0, 2: 1, 3: 2, 4: 3, 5: 4, 6: 0}
+
+	tests := []struct {
+		name     string
+		id       int64
+		parentID int64
+		wantErr  string
+	}{
+		{name: "own parent", id: 6, parentID: 6, wantErr: "cannot be its own parent"},
+		{name: "root parent", id: 6, parentID: 1},
+		{name: "new tag", id: 0, parentID: 3},
+		{name: "missing parent", id: 6, parentID: 99, wantErr: "could not look up parent tag 99"},
+		{name: "own descendant", id: 2, parentID: 4, wantErr: "would create a cycle"},
+		{name: "too deep", id: 6, parentID: 5, wantErr: "maximum tag depth"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &TagResource{client: newTestClient(t, testTagTree(t, parents))}
+
+			err := r.validateParent(context.Background(), tt.id, tt.parentID)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("validateParent() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("validateParent() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTagResourceUpdateSelfParent(t *testing.T) {
+	ctx := context.Background()
+
+	client := newTestClient(t, func(req testRequest) ([]map[string]any, error) {
+		t.Errorf("unexpected request %s.%s", req.Entity, req.Action)
+		return nil, errors.New("unexpected request")
+	})
+
+	r := &TagResource{}
+	s := newTestResource(t, r, client)
+
+	state := TagResourceModel{
+		ID:                   types.Int64Value(6),
+		Name:                 types.StringValue("donor"),
+		Label:                types.StringValue("Donor"),
+		Description:          types.StringNull(),
+		ParentID:             types.Int64Null(),
+		IsSelectable:         types.BoolValue(true),
+		IsReserved:           types.BoolValue(false),
+		IsTagset:             types.BoolValue(false),
+		UsedFor:              types.ListNull(types.StringType),
+		Color:                types.StringNull(),
+		AllowReservedChanges: types.BoolValue(false),
+		IgnoreFields:         types.ListNull(types.StringType),
+	}
+	plan := state
+	plan.ParentID = types.Int64Value(6)
+
+	resp := &resource.UpdateResponse{State: testState(t, s, state)}
+	r.Update(ctx, resource.UpdateRequest{
+		Plan:   testPlan(t, s, plan),
+		Config: testConfig(t, s, plan),
+		State:  testState(t, s, state),
+	}, resp)
+
+	if !hasAttributeDiagnostic(resp.Diagnostics, diag.SeverityError, "parent_id") {
+		t.Errorf("missing parent_id error: %v", resp.Diagnostics)
+	}
+}
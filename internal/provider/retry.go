@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Default retry behaviour of the client. CiviCRM is usually reached through
+// PHP-FPM behind a reverse proxy, which answers with 502-504 while workers are
+// busy, and MySQL aborts one of two conflicting transactions with a deadlock
+// error that succeeds when simply repeated.
+const (
+	defaultMaxRetries = 3
+	defaultRetryWait  = time.Second
+	maxRetryWait      = 30 * time.Second
+)
+
+// transientErrorMessages are the database errors after which a request can be
+// repeated: the transaction was rolled back before any change was saved.
+var transientErrorMessages = []string{
+	"deadlock found when trying to get lock",
+	"lock wait timeout exceeded",
+}
+
+// readOnlyActions are the API actions that change nothing, so that they can be
+// repeated after any failure.
+var readOnlyActions = map[string]bool{
+	"get":       true,
+	"getFields": true,
+	"check":     true,
+}
+
+// send performs the request built by newRequest for an API action and returns
+// the status code and body of the response. Transient failures are retried up
+// to maxRetries times with exponential backoff, unless ctx is done. newRequest
+// is called for every attempt, because the body of a request can only be read
+// once.
+func (c *Client) send(ctx context.Context, action string, newRequest func() (*http.Request, error)) (int, []byte, error) {
+	for attempt := 0; ; attempt++ {
+		req, err := newRequest()
+		if err != nil {
+			return 0, nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		if c.limiter != nil {
+			if err := c.limiter.wait(ctx); err != nil {
+				return 0, nil, fmt.Errorf("request failed: %w", err)
+			}
+		}
+
+		start := time.Now()
+		statusCode, body, retryAfter, err := c.sendOnce(req)
+		logExchange(ctx, req, attempt, time.Since(start), statusCode, body, err)
+
+		if attempt >= c.maxRetries || ctx.Err() != nil || !isTransient(statusCode, body, err, readOnlyActions[action]) {
+			return statusCode, body, err
+		}
+
+		timer := time.NewTimer(c.retryDelay(attempt, retryAfter))
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return 0, nil, fmt.Errorf("request failed: %w", ctx.Err())
+		}
+	}
+}
+
+// sendOnce performs a single attempt of a request. retryAfter is the delay
+// requested by a Retry-After header, or 0.
+func (c *Client) sendOnce(req *http.Request) (statusCode int, body []byte, retryAfter time.Duration, err error) {
+	if c.requestTimeout > 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.requestTimeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, nil, 0, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, nil, 0, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+		retryAfter = time.Duration(seconds) * time.Second
+	}
+
+	return resp.StatusCode, body, retryAfter, nil
+}
+
+// isTransient reports whether a failed attempt is worth repeating: connection
+// errors, rate limiting, unavailable upstreams and database lock conflicts.
+// Requests that change data are only repeated when they were not processed:
+// after a timeout or a 502 or 504 the server may have saved the change
+// already, and repeating a create would save it twice.
+func isTransient(statusCode int, body []byte, err error, readOnly bool) bool {
+	if err != nil {
+		return readOnly || isDialError(err)
+	}
+
+	switch statusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return readOnly
+	}
+
+	if statusCode >= 200 && statusCode < 300 {
+		return false
+	}
+
+	message := strings.ToLower(string(body))
+	for _, transient := range transientErrorMessages {
+		if strings.Contains(message, transient) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isDialError reports whether err occurred while connecting to the server, so
+// that the request was never sent.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// retryDelay returns how long to wait before the retry that follows the
+// given attempt: retryWait doubled for every attempt, with jitter so that
+// parallel requests do not retry in lockstep, and at least retryAfter.
+func (c *Client) retryDelay(attempt int, retryAfter time.Duration) time.Duration {
+	wait := c.retryWait << attempt
+	if wait <= 0 || wait > maxRetryWait {
+		wait = maxRetryWait
+	}
+
+	// Full wait minus up to half of it
+	wait -= time.Duration(rand.Int63n(int64(wait)/2 + 1))
+
+	if retryAfter > wait {
+		wait = min(retryAfter, maxRetryWait)
+	}
+
+	return wait
+}
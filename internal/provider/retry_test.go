@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIsTransient(t *testing.T) {
+	dialErr := fmt.Errorf("request failed: %w", &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")})
+	readErr := fmt.Errorf("request failed: %w", &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")})
+	deadlock := []byte(`{"error_message":"DB Error: Deadlock found when trying to get lock; try restarting transaction"}`)
+	lockWait := []byte(`{"error_message":"DB Error: Lock wait timeout exceeded"}`)
+	duplicate := []byte(`{"error_message":"DB Error: already exists"}`)
+
+	tests := []struct {
+		name       string
+		statusCode int
+		body       []byte
+		err        error
+		readOnly   bool
+		want       bool
+	}{
+		{name: "success", statusCode: http.StatusOK, readOnly: true},
+		{name: "success mentioning a deadlock", statusCode: http.StatusOK, body: deadlock, readOnly: false},
+		{name: "dial error", err: dialErr, readOnly: false, want: true},
+		{name: "read error on get", err: readErr, readOnly: true, want: true},
+		{name: "read error on create", err: readErr, readOnly: false},
+		{name: "timeout on create", err: context.DeadlineExceeded, readOnly: false},
+		{name: "rate limited create", statusCode: http.StatusTooManyRequests, readOnly: false, want: true},
+		{name: "bad gateway on get", statusCode: http.StatusBadGateway, readOnly: true, want: true},
+		{name: "bad gateway on create", statusCode: http.StatusBadGateway, readOnly: false},
+		{name: "unavailable on get", statusCode: http.StatusServiceUnavailable, readOnly: true, want: true},
+		{name: "unavailable on update", statusCode: http.StatusServiceUnavailable, readOnly: false},
+		{name: "gateway timeout on create", statusCode: http.StatusGatewayTimeout, readOnly: false},
+		{name: "deadlock on create", statusCode: http.StatusInternalServerError, body: deadlock, readOnly: false, want: true},
+		{name: "lock wait on delete", statusCode: http.StatusInternalServerError, body: lockWait, readOnly: false, want: true},
+		{name: "other error on get", statusCode: http.StatusInternalServerError, body: duplicate, readOnly: true},
+		{name: "forbidden", statusCode: http.StatusForbidden, readOnly: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isTransient(tt.statusCode, tt.body, tt.err, tt.readOnly); got != tt.want {
+				t.Errorf("isTransient() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientRetries(t *testing.T) {
+	tests := []struct {
+		name         string
+		action       string
+		statusCodes  []int
+		wantAttempts int
+		wantErr      bool
+	}{
+		{name: "get after unavailable", action: "get", statusCodes: []int{503, 200}, wantAttempts: 2},
+		{name: "create after unavailable", action: "create", statusCodes: []int{503, 200}, wantAttempts: 1, wantErr: true},
+		{name: "create after rate limit", action: "create", statusCodes: []int{429, 200}, wantAttempts: 2},
+		{name: "get gives up", action: "get", statusCodes: []int{502, 502, 502}, wantAttempts: 3, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				statusCode := tt.statusCodes[attempts]
+				attempts++
+				w.WriteHeader(statusCode)
+				if statusCode == http.StatusOK {
+					fmt.Fprint(w, `{"version":4,"count":1,"values":[{"id":1}]}`)
+				}
+			}))
+			defer server.Close()
+
+			client, err := NewClient(server.URL, "secret", "", false)
+			if err != nil {
+				t.Fatalf("NewClient: %v", err)
+			}
+			client.maxRetries = 2
+			client.retryWait = time.Millisecond
+
+			var requestErr error
+			if tt.action == "get" {
+				_, requestErr = client.Get(context.Background(), "Group", nil, nil)
+			} else {
+				_, requestErr = client.Create(context.Background(), "Group", map[string]any{"name": "volunteers"})
+			}
+
+			if (requestErr != nil) != tt.wantErr {
+				t.Errorf("error = %v, wantErr %t", requestErr, tt.wantErr)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+		})
+	}
+}
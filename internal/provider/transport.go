@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// addCACertificates makes the client trust the PEM encoded CA certificates in
+// addition to the CAs of the system, for servers behind an internal CA.
+func (c *Client) addCACertificates(pemCerts []byte) error {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		// The system pool is not available on every platform
+		pool = x509.NewCertPool()
+	}
+
+	if !pool.AppendCertsFromPEM(pemCerts) {
+		return fmt.Errorf("no PEM encoded certificate found")
+	}
+
+	c.transport.TLSClientConfig.RootCAs = pool
+	return nil
+}
+
+// setClientCertificate makes the client present the PEM encoded certificate
+// and key, for servers that require TLS client authentication.
+func (c *Client) setClientCertificate(certPEM, keyPEM []byte) error {
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return err
+	}
+
+	c.transport.TLSClientConfig.Certificates = []tls.Certificate{cert}
+	return nil
+}
+
+// setProxy sends all requests through the proxy at proxyURL instead of the
+// proxy from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+func (c *Client) setProxy(proxyURL string) error {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return err
+	}
+
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return fmt.Errorf("unsupported proxy scheme %q, expected http, https or socks5", u.Scheme)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("proxy URL %q has no host", proxyURL)
+	}
+
+	c.transport.Proxy = http.ProxyURL(u)
+	return nil
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// managedWeightAttribute returns the schema for a weight that is only managed
+// by Terraform when it is configured. Otherwise CiviCRM assigns and renumbers
+// it, and the assigned value is kept in state without producing a diff.
+func managedWeightAttribute(noun string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description: fmt.Sprintf("The display order weight of the %s. When not set, CiviCRM assigns and renumbers the weight.", noun),
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// configuredWeight returns the weight set in config. ok is false when the
+// weight is left to CiviCRM and must not be sent.
+func configuredWeight(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) (int64, bool) {
+	var weight types.Int64
+	diags.Append(config.GetAttribute(ctx, path.Root("weight"), &weight)...)
+	if weight.IsNull() || weight.IsUnknown() {
+		return 0, false
+	}
+	return weight.ValueInt64(), true
+}